@@ -12,6 +12,7 @@ type AbiConverter struct {
 	imports      map[string]bool
 	customTypes  map[string]bool
 	complexTypes map[string][][2]string
+	declaredVars map[string]bool
 }
 
 func NewAbiConverter(fileName string) (*AbiConverter, error) {
@@ -19,6 +20,7 @@ func NewAbiConverter(fileName string) (*AbiConverter, error) {
 		imports:      make(map[string]bool),
 		customTypes:  make(map[string]bool),
 		complexTypes: make(map[string][][2]string),
+		declaredVars: make(map[string]bool),
 	}
 	abi, err := converter.loadAbiFile(fileName)
 	if err != nil {
@@ -66,22 +66,19 @@ func (conv *AbiConverter) generateMutableBody(endpoint data.AbiEndpoint) ([]stri
 
 	// generate endpoint sending transaction
 	isEsdtTx := len(endpoint.PayableInTokens) == 1 && endpoint.PayableInTokens[0] == "*"
-	line := ""
 	if isEsdtTx {
-		line = fmt.Sprintf("    dataField := hex.EncodeToString([]byte(\"%s\"))", endpoint.Name)
-	} else {
-		line = fmt.Sprintf("    dataField := \"%s\"", endpoint.Name)
-	}
-	if len(inputArgs) > 0 {
-		conv.imports["strings"] = true
-		line += " + \"@\" + strings.Join(_args, \"@\")"
-	}
-	lines = append(lines, line)
-
-	// send transaction
-	if isEsdtTx {
-		lines = append(lines, "    hash, err := contract.netMan.SendEsdtTransaction(_pk, contract.contractAddress, _value, _gasLimit, _token, dataField, _nonce)")
+		args := "nil"
+		if len(inputArgs) > 0 {
+			args = "_args"
+		}
+		lines = append(lines, fmt.Sprintf("    hash, err := contract.netMan.SendEsdtTransaction(_pk, contract.contractAddress, _value, _gasLimit, _token, \"%s\", %s, _nonce)", endpoint.Name, args))
 	} else {
+		line := fmt.Sprintf("    dataField := \"%s\"", endpoint.Name)
+		if len(inputArgs) > 0 {
+			conv.imports["strings"] = true
+			line += " + \"@\" + strings.Join(_args, \"@\")"
+		}
+		lines = append(lines, line)
 		lines = append(lines, "    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)")
 	}
 
@@ -337,6 +337,7 @@ func (conv *AbiConverter) parseAnyType(typeName string, goType string, varName s
 			}
 
 			lines = append(lines, iLines...)
+			conv.declaredVars = make(map[string]bool)
 			cLines, err := conv.parseComplexType(complexType, typeName, goType, varName, dataSource, indent, newVars, "return", output, allOutputs)
 			if err != nil {
 				return nil, err
@@ -373,6 +374,9 @@ func (conv *AbiConverter) instantiateParser(goType string, allVars bool, indent
 			if fieldType == "TokenIdentifier" || fieldType == "EgldOrEsdtTokenIdentifier" {
 				fieldType = "string"
 			}
+			if conv.abi.Types[fieldType] != nil && conv.abi.Types[fieldType].Type == "enum" {
+				fieldType = "byte"
+			}
 			lines = append(lines, fmt.Sprintf("%svar _%s %s", indent, fieldName, fieldType))
 		}
 	}
@@ -448,7 +452,15 @@ func (conv *AbiConverter) parseComplexType(complexType [][2]string, typeName str
 	for _, ct := range complexType {
 		fieldName := ct[0]
 		fieldType := ct[1]
-		fieldLine := fmt.Sprintf("%s_%s, idx, ok %s= utils.Parse", indent, fieldName, attribute)
+		// fields with the same name in nested structs share the same variable
+		fieldAttribute := attribute
+		if newVars {
+			if conv.declaredVars[indent+fieldName] {
+				fieldAttribute = ""
+			}
+			conv.declaredVars[indent+fieldName] = true
+		}
+		fieldLine := fmt.Sprintf("%s_%s, idx, ok %s= utils.Parse", indent, fieldName, fieldAttribute)
 		parseType, err := conv.getParseType(fieldType)
 		if err != nil {
 			return nil, err
@@ -231,9 +231,17 @@ func (conv *AbiConverter) appendContractType(lines *[]string) {
 			"    }\n" +
 			"\n" +
 			"    return contract, nil\n" +
+			"}\n" +
+			"\n" +
+			"func New%sWithNetworkManager(contractAddress string, netMan *network.NetworkManager) *%s {\n" +
+			"    return &%s{\n" +
+			"        netMan:          netMan,\n" +
+			"        contractAddress: contractAddress,\n" +
+			"    }\n" +
 			"}\n"
 	)
-	line := fmt.Sprintf(contractType, conv.abi.Name, conv.abi.Name, conv.abi.Name, conv.abi.Name)
+	name := conv.abi.Name
+	line := fmt.Sprintf(contractType, name, name, name, name, name, name, name)
 	*lines = append(*lines, strings.Split(line, "\n")...)
 	conv.imports["github.com/stakingagency/sa-mx-sdk-go/network"] = true
 }
@@ -21,6 +21,8 @@ import (
 type (
 	EgldBalanceChangedCallbackFunc  func(oldBalance float64, newBalance float64)
 	TokenBalanceChangedCallbackFunc func(ticker string, oldBalance float64, newBalance float64)
+	NftReceivedCallbackFunc         func(nft *data.NFT)
+	NftSentCallbackFunc             func(nft *data.NFT)
 )
 
 type Account struct {
@@ -31,10 +33,15 @@ type Account struct {
 	cachedEgldBalance       float64
 	cachedTokensBalances    map[string]float64
 	cachedTokensBalancesMut sync.Mutex
+	cachedNfts              map[string]*data.NFT
+	cachedNftsMut           sync.Mutex
 	cachedEsdts             map[string]*data.ESDT
+	cachedEsdtsMut          sync.Mutex
 
 	egldBalanceChangedCallback  EgldBalanceChangedCallbackFunc
 	tokenBalanceChangedCallback TokenBalanceChangedCallbackFunc
+	nftReceivedCallback         NftReceivedCallbackFunc
+	nftSentCallback             NftSentCallbackFunc
 }
 
 var log = logger.GetOrCreate("accounts")
@@ -46,10 +53,13 @@ func NewAccount(address string, nm *network.NetworkManager, refreshInterval time
 		refreshInterval: refreshInterval,
 
 		cachedTokensBalances: make(map[string]float64),
+		cachedNfts:           make(map[string]*data.NFT),
 		cachedEsdts:          make(map[string]*data.ESDT),
 
 		egldBalanceChangedCallback:  nil,
 		tokenBalanceChangedCallback: nil,
+		nftReceivedCallback:         nil,
+		nftSentCallback:             nil,
 	}
 	acc.startTasks()
 
@@ -64,6 +74,14 @@ func (acc *Account) SetTokenBalanceChangedCallback(f TokenBalanceChangedCallback
 	acc.tokenBalanceChangedCallback = f
 }
 
+func (acc *Account) SetNftReceivedCallback(f NftReceivedCallbackFunc) {
+	acc.nftReceivedCallback = f
+}
+
+func (acc *Account) SetNftSentCallback(f NftSentCallbackFunc) {
+	acc.nftSentCallback = f
+}
+
 func (acc *Account) GetAddress() string {
 	return acc.address
 }
@@ -178,7 +196,11 @@ func (acc *Account) GetTokensBalances() (map[string]float64, error) {
 			continue
 		}
 
-		ticker := string(bTicker)
+		ticker, nonce, ok := utils.SplitEsdtKey(bTicker)
+		if !ok || nonce != 0 {
+			continue
+		}
+
 		decimals, err := acc.GetTokenDecimals(ticker)
 		if err != nil {
 			decimals = 18
@@ -209,7 +231,11 @@ func (acc *Account) GetRawTokensBalances() (map[string]*big.Int, error) {
 			continue
 		}
 
-		ticker := string(bTicker)
+		ticker, nonce, ok := utils.SplitEsdtKey(bTicker)
+		if !ok || nonce != 0 {
+			continue
+		}
+
 		bBalance, _, ok := utils.ParseByteArray(value, 1)
 		if !ok {
 			continue
@@ -0,0 +1,184 @@
+package accounts
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/stakingagency/sa-mx-sdk-go/data"
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+func (acc *Account) GetNFTs() (map[string]*data.NFT, error) {
+	endpoint := fmt.Sprintf("address/%s/esdt", acc.address)
+	response := &data.AccountEsdtsResponse{}
+	err := acc.netMan.QueryProxy(endpoint, response)
+	if err != nil {
+		log.Error("query proxy", "error", err, "endpoint", endpoint, "function", "GetNFTs")
+		return nil, err
+	}
+
+	if response.Error != "" {
+		log.Error("http response (get)", "error", response.Error, "endpoint", endpoint, "function", "GetNFTs")
+		return nil, errors.New(response.Error)
+	}
+
+	res := make(map[string]*data.NFT)
+	for _, esdt := range response.Data.Esdts {
+		if esdt.Nonce == 0 {
+			continue
+		}
+
+		nft, err := acc.newNFT(esdt)
+		if err != nil {
+			log.Debug("parse nft", "error", err, "identifier", esdt.TokenIdentifier, "function", "GetNFTs")
+			continue
+		}
+
+		res[nft.Identifier] = nft
+	}
+
+	return res, nil
+}
+
+func (acc *Account) GetCollectionNFTs(collection string) ([]*data.NFT, error) {
+	nfts, err := acc.GetNFTs()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*data.NFT, 0)
+	for _, nft := range nfts {
+		if nft.Collection == collection {
+			res = append(res, nft)
+		}
+	}
+
+	return res, nil
+}
+
+func (acc *Account) GetNFT(collection string, nonce uint64) (*data.NFT, error) {
+	endpoint := fmt.Sprintf("address/%s/nft/%s/nonce/%v", acc.address, collection, nonce)
+	response := &data.AccountNftResponse{}
+	err := acc.netMan.QueryProxy(endpoint, response)
+	if err != nil {
+		log.Error("query proxy", "error", err, "endpoint", endpoint, "function", "GetNFT")
+		return nil, err
+	}
+
+	if response.Error != "" {
+		log.Error("http response (get)", "error", response.Error, "endpoint", endpoint, "function", "GetNFT")
+		return nil, errors.New(response.Error)
+	}
+
+	if response.Data.TokenData == nil {
+		return nil, utils.ErrInvalidResponse
+	}
+
+	esdt := response.Data.TokenData
+	if esdt.TokenIdentifier == "" {
+		esdt.TokenIdentifier = utils.GetNftIdentifier(collection, nonce)
+	}
+	esdt.Nonce = nonce
+
+	return acc.newNFT(esdt)
+}
+
+func (acc *Account) GetCachedNFTs() (map[string]*data.NFT, error) {
+	if acc.refreshInterval == utils.NoRefresh {
+		return nil, utils.ErrRefreshIntervalNotSet
+	}
+
+	res := make(map[string]*data.NFT)
+	acc.cachedNftsMut.Lock()
+	for k, v := range acc.cachedNfts {
+		res[k] = v
+	}
+	acc.cachedNftsMut.Unlock()
+
+	return res, nil
+}
+
+func (acc *Account) newNFT(esdt *data.AccountEsdt) (*data.NFT, error) {
+	idx := strings.LastIndex(esdt.TokenIdentifier, "-")
+	if idx <= 0 {
+		return nil, utils.ErrInvalidResponse
+	}
+
+	collection := esdt.TokenIdentifier[:idx]
+	token, err := acc.getCollection(collection)
+	if err != nil {
+		return nil, err
+	}
+
+	quantity, ok := big.NewInt(0).SetString(esdt.Balance, 10)
+	if !ok {
+		return nil, utils.ErrInvalidResponse
+	}
+
+	royalties, _ := strconv.ParseFloat(esdt.Royalties.String(), 64)
+	uris := make([]string, 0, len(esdt.Uris))
+	for _, uri := range esdt.Uris {
+		uris = append(uris, string(uri))
+	}
+
+	nft := &data.NFT{
+		Identifier:        esdt.TokenIdentifier,
+		Collection:        collection,
+		Nonce:             esdt.Nonce,
+		Type:              token.Type,
+		Name:              esdt.Name,
+		Creator:           esdt.Creator,
+		Royalties:         royalties / 100,
+		Hash:              esdt.Hash,
+		URIs:              uris,
+		Decimals:          token.Decimals,
+		Attributes:        esdt.Attributes,
+		DecodedAttributes: utils.ParseNftAttributes(esdt.Attributes),
+		Quantity:          quantity,
+		Balance:           utils.Denominate(quantity, int(token.Decimals)),
+	}
+
+	return nft, nil
+}
+
+func (acc *Account) getCollection(collection string) (*data.ESDT, error) {
+	acc.cachedEsdtsMut.Lock()
+	token := acc.cachedEsdts[collection]
+	acc.cachedEsdtsMut.Unlock()
+	if token != nil {
+		return token, nil
+	}
+
+	args := []string{hex.EncodeToString([]byte(collection))}
+	res, err := acc.netMan.QueryScMultiIntResult(utils.EsdtIssueSC, "getTokenProperties", args)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res) < 6 {
+		return nil, utils.ErrInvalidResponse
+	}
+
+	sDecimals := strings.TrimPrefix(string(res[5].Bytes()), "NumDecimals-")
+	decimals, err := strconv.ParseUint(sDecimals, 10, 64)
+	if err != nil {
+		return nil, utils.ErrInvalidResponse
+	}
+
+	token = &data.ESDT{
+		Name:        string(res[0].Bytes()),
+		Type:        string(res[1].Bytes()),
+		Ticker:      collection,
+		ShortTicker: strings.Split(collection, "-")[0],
+		Decimals:    decimals,
+	}
+	acc.cachedEsdtsMut.Lock()
+	acc.cachedEsdts[collection] = token
+	acc.cachedEsdtsMut.Unlock()
+
+	return token, nil
+}
@@ -19,6 +19,7 @@ func (acc *Account) startTasks() {
 
 			acc.refreshEgldBalance()
 			acc.refreshTokensBalances()
+			acc.refreshNfts()
 
 			endTime := time.Now().UnixNano()
 			waitTime := acc.refreshInterval - time.Duration(endTime-startTime)
@@ -73,3 +74,31 @@ func (acc *Account) refreshTokensBalances() {
 	acc.cachedTokensBalances = newTokensBalances
 	acc.cachedTokensBalancesMut.Unlock()
 }
+
+func (acc *Account) refreshNfts() {
+	newNfts, err := acc.GetNFTs()
+	if err != nil {
+		log.Error("get nfts", "error", err, "address", acc.address, "function", "refreshNfts")
+		return
+	}
+
+	acc.cachedNftsMut.Lock()
+	oldNfts := acc.cachedNfts
+	acc.cachedNfts = newNfts
+	acc.cachedNftsMut.Unlock()
+
+	if !initialized {
+		return
+	}
+
+	for identifier, nft := range newNfts {
+		if oldNfts[identifier] == nil && acc.nftReceivedCallback != nil {
+			acc.nftReceivedCallback(nft)
+		}
+	}
+	for identifier, nft := range oldNfts {
+		if newNfts[identifier] == nil && acc.nftSentCallback != nil {
+			acc.nftSentCallback(nft)
+		}
+	}
+}
@@ -37,6 +37,17 @@ type IndexerEntry struct {
 
 		// logs
 		Events []*IndexerEvent `json:"events"`
+
+		// delegators
+		Address        string  `json:"address"`
+		Contract       string  `json:"contract"`
+		ActiveStake    string  `json:"activeStake"`
+		ActiveStakeNum float64 `json:"activeStakeNum"`
+
+		// tokens
+		Token        string `json:"token"`
+		Type         string `json:"type"`
+		CurrentOwner string `json:"currentOwner"`
 	} `json:"_source"`
 	Sort []interface{} `json:"sort"`
 }
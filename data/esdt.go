@@ -1,19 +1,61 @@
 package data
 
+import "math/big"
+
 type ESDT struct {
 	Name        string
 	Ticker      string
 	ShortTicker string
 	Decimals    uint64
 	Type        string
+	Owner       string
 	IsPaused    bool
 
+	CanUpgrade               bool
+	CanMint                  bool
+	CanBurn                  bool
+	CanChangeOwner           bool
+	CanPause                 bool
+	CanFreeze                bool
+	CanWipe                  bool
+	CanAddSpecialRoles       bool
+	CanTransferNFTCreateRole bool
+	NFTCreateStopped         bool
+	SpecialRoles             map[string][]string
+
 	Supply        float64
 	Minted        float64
 	Burned        float64
 	InitialMinted float64
 }
 
+type EsdtStorage struct {
+	Owner      string
+	TokenName  string
+	TickerName string
+	TokenType  string
+
+	Mintable   bool
+	Burnable   bool
+	Upgradable bool
+	Paused     bool
+
+	MintedValue *big.Int
+	BurntValue  *big.Int
+	NumDecimals uint32
+
+	CanPause                 bool
+	CanFreeze                bool
+	CanWipe                  bool
+	CanChangeOwner           bool
+	CanAddSpecialRoles       bool
+	CanTransferNFTCreateRole bool
+	NFTCreateStopped         bool
+	SpecialRoles             map[string][]string
+	NumWiped                 uint32
+	CanCreateMultiShard      bool
+}
+
 type EsdtMintInfoResponse struct {
 	Data  EsdtMintInfo `json:"data"`
 	Error string       `json:"error"`
@@ -0,0 +1,56 @@
+package data
+
+import "math/big"
+
+type GovernanceConfig struct {
+	ContractAddress                 string
+	Version                         byte
+	EnergyFactoryAddress            string
+	FeeToken                        string
+	MinEnergyForPropose             float64
+	MinFeeForPropose                float64
+	Quorum                          float64
+	VotingDelayInBlocks             uint64
+	VotingPeriodInBlocks            uint64
+	LockTimeAfterVotingEndsInBlocks uint64
+
+	// v1 only
+	VoteNftToken        string
+	MexToken            string
+	GovernanceTokens    []string
+	MinWeightForPropose float64
+}
+
+type Proposal struct {
+	ID            uint32
+	Proposer      string
+	Description   string
+	Actions       []*ProposalAction
+	Status        string
+	UpVotes       float64
+	DownVotes     float64
+	DownVetoVotes float64
+	AbstainVotes  float64
+	TotalVotes    float64
+	Quorum        float64
+	QuorumReached bool
+}
+
+type ProposalAction struct {
+	GasLimit     uint64
+	DestAddress  string
+	FunctionName string
+	Arguments    [][]byte
+}
+
+// a v1 vote, held by the voter as a vote NFT until it is redeemed
+type GovernanceVote struct {
+	Nonce         uint64
+	ProposalID    uint32
+	VoteType      byte
+	Weight        float64
+	Voter         string
+	PaymentToken  string
+	PaymentNonce  uint64
+	PaymentAmount *big.Int
+}
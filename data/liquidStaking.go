@@ -0,0 +1,26 @@
+package data
+
+type LiquidStakingInfo struct {
+	Protocol        string
+	ContractAddress string
+	LiquidToken     string
+	ExchangeRate    float64
+	TVL             float64
+}
+
+type LiquidStakingPosition struct {
+	Protocol      string
+	Address       string
+	LiquidToken   string
+	LiquidBalance float64
+	EgldValue     float64
+	Undelegating  float64
+	Withdrawable  float64
+}
+
+type LiquidUndelegation struct {
+	Amount       float64
+	UnbondEpoch  uint64
+	Withdrawable bool
+	TokenNonce   uint64
+}
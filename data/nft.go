@@ -0,0 +1,53 @@
+package data
+
+import (
+	"encoding/json"
+	"math/big"
+)
+
+type NFT struct {
+	Identifier string
+	Collection string
+	Nonce      uint64
+	Type       string
+	Name       string
+	Creator    string
+	Royalties  float64
+	Hash       []byte
+	URIs       []string
+	Decimals   uint64
+
+	Attributes        []byte
+	DecodedAttributes map[string]string
+
+	Quantity *big.Int
+	Balance  float64
+}
+
+type AccountEsdtsResponse struct {
+	Data struct {
+		Esdts map[string]*AccountEsdt `json:"esdts"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type AccountNftResponse struct {
+	Data struct {
+		TokenData *AccountEsdt `json:"tokenData"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type AccountEsdt struct {
+	TokenIdentifier string      `json:"tokenIdentifier"`
+	Balance         string      `json:"balance"`
+	Nonce           uint64      `json:"nonce"`
+	Name            string      `json:"name"`
+	Creator         string      `json:"creator"`
+	Royalties       json.Number `json:"royalties"`
+	Hash            []byte      `json:"hash"`
+	Uris            [][]byte    `json:"uris"`
+	Attributes      []byte      `json:"attributes"`
+}
@@ -0,0 +1,12 @@
+package data
+
+type TokenPrice struct {
+	Ticker     string
+	Price      float64
+	Liquidity  float64
+	Confidence float64
+	Hops       int
+	Sources    []string
+	Timestamp  int64
+	Stale      bool
+}
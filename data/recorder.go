@@ -0,0 +1,26 @@
+package data
+
+type RecordedPair struct {
+	Key    string
+	Dex    string
+	Pool   string
+	Token1 string
+	Token2 string
+}
+
+type PriceSample struct {
+	Timestamp int64
+	Reserve1  float64
+	Reserve2  float64
+	Price     float64
+	Volume    float64
+}
+
+type Candle struct {
+	Start  int64
+	Open   float64
+	High   float64
+	Low    float64
+	Close  float64
+	Volume float64
+}
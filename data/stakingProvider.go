@@ -1,5 +1,7 @@
 package data
 
+import "time"
+
 type StakingProvider struct {
 	ContractAddress  string
 	Owner            string
@@ -10,4 +12,104 @@ type StakingProvider struct {
 	MaxDelegationCap float64
 	HasDelegationCap bool
 	ActiveStake      float64
+
+	AutomaticActivation  bool
+	ChangeableServiceFee bool
+	CheckCapOnRedelegate bool
+	UnbondPeriod         uint64
+}
+
+type ProviderNode struct {
+	BlsKey          string
+	Status          string
+	DelegationState string
+	StakingStatus   string
+	ValidatorStatus string
+	ShardID         uint32
+	Rating          float64
+	TempRating      float64
+	TopUp           float64
+}
+
+type ValidatorStatisticsResponse struct {
+	Data struct {
+		Statistics map[string]*ValidatorStatistics `json:"statistics"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type ValidatorStatistics struct {
+	TempRating      float64 `json:"tempRating"`
+	Rating          float64 `json:"rating"`
+	ValidatorStatus string  `json:"validatorStatus"`
+	ShardID         uint32  `json:"shardId"`
+}
+
+type DelegationResult struct {
+	TxHash    string
+	Operation string
+	Amount    float64
+	Rewards   float64
+}
+
+type Delegator struct {
+	Address     string
+	ActiveStake float64
+}
+
+type DelegatorsDistribution struct {
+	ContractAddress string
+	DelegatorsCount int
+	TotalStake      float64
+	AverageStake    float64
+	MedianStake     float64
+	TopHolders      []*Delegator
+	TopHoldersShare float64
+	Top10Share      float64
+	Top100Share     float64
+	Gini            float64
+	NakamotoIndex   int
+}
+
+type NetworkEconomics struct {
+	TotalSupply        float64
+	TotalStaked        float64
+	TotalTopUp         float64
+	TotalBaseStake     float64
+	EpochInflation     float64
+	EpochsPerYear      float64
+	YearlyRewards      float64
+	BaseRewards        float64
+	TopUpRewards       float64
+	SustainabilityFund float64
+}
+
+type ProviderAPR struct {
+	ContractAddress string
+	Name            string
+	ServiceFee      float64
+	StakedNodes     int
+	TotalStake      float64
+	TopUp           float64
+	TopUpPerNode    float64
+	GrossAPR        float64
+	NetAPR          float64
+	NetAPY          float64
+}
+
+type Undelegation struct {
+	Amount          float64
+	UnbondEpoch     uint64
+	RemainingEpochs uint64
+	Countdown       time.Duration
+	UnbondTime      time.Time
+	Withdrawable    bool
+}
+
+type RewardsHistoryEntry struct {
+	TxHash    string
+	Operation string
+	Amount    float64
+	Timestamp int64
 }
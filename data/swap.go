@@ -0,0 +1,42 @@
+package data
+
+import "math/big"
+
+type SwapResult struct {
+	TxHash            string
+	Pair              string
+	TokenIn           string
+	TokenOut          string
+	AmountIn          float64
+	AmountOut         float64
+	ExpectedAmountOut float64
+	MinAmountOut      float64
+}
+
+type EsdtTransfer struct {
+	Ticker string
+	Nonce  uint64
+	Amount *big.Int
+}
+
+type LiquidityResult struct {
+	TxHash   string
+	Pair     string
+	Token1   string
+	Token2   string
+	LpToken  string
+	Amount1  float64
+	Amount2  float64
+	LpAmount float64
+}
+
+type SwapEvent struct {
+	TxHash     string
+	Timestamp  int64
+	TokenIn    string
+	TokenOut   string
+	AmountIn   *big.Int
+	AmountOut  *big.Int
+	ReserveIn  *big.Int
+	ReserveOut *big.Int
+}
@@ -352,8 +352,7 @@ func (contract *SalsaContract) Delegate(_pk []byte, _value float64, _gasLimit ui
 }
 
 func (contract *SalsaContract) UnDelegate(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64) error {
-    dataField := hex.EncodeToString([]byte("unDelegate"))
-    hash, err := contract.netMan.SendEsdtTransaction(_pk, contract.contractAddress, _value, _gasLimit, _token, dataField, _nonce)
+    hash, err := contract.netMan.SendEsdtTransaction(_pk, contract.contractAddress, _value, _gasLimit, _token, "unDelegate", nil, _nonce)
     if err != nil {
         return err
     }
@@ -416,8 +415,7 @@ func (contract *SalsaContract) RemoveReserve(_pk []byte, _value float64, _gasLim
 func (contract *SalsaContract) UnDelegateNow(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64, min_amount_out *big.Int) error {
     _args := make([]string, 0)
     _args = append(_args, hex.EncodeToString(min_amount_out.Bytes()))
-    dataField := hex.EncodeToString([]byte("unDelegateNow")) + "@" + strings.Join(_args, "@")
-    hash, err := contract.netMan.SendEsdtTransaction(_pk, contract.contractAddress, _value, _gasLimit, _token, dataField, _nonce)
+    hash, err := contract.netMan.SendEsdtTransaction(_pk, contract.contractAddress, _value, _gasLimit, _token, "unDelegateNow", _args, _nonce)
     if err != nil {
         return err
     }
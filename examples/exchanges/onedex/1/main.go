@@ -6,7 +6,7 @@ import (
 
 	"github.com/stakingagency/sa-mx-sdk-go/exchanges/onedex"
 	"github.com/stakingagency/sa-mx-sdk-go/network"
-	"github.com/stakingagency/sa-mx-sdk-go/utils"
+	"github.com/stakingagency/sa-mx-sdk-go/pricing"
 )
 
 const (
@@ -71,11 +71,22 @@ func main() {
 	one.SetPairStateChangedCallback(pairStateChanged)
 	one.SetStakeAprChangedCallback(stakeAprChanged)
 
+	oracle, err := pricing.NewOracle(nil, one, time.Minute)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	oracle.AddSource(pricing.NewBinanceSource())
 	for {
-		egldPrice := utils.GetBinancePrice("EGLD")
-		price := one.GetCachedTokenPrice(onedex.OneToken, egldPrice)
-		fmt.Printf("%s price is %.6f\n", onedex.OneToken, price)
 		time.Sleep(time.Minute)
+		price, err := oracle.GetCachedPrice(onedex.OneToken)
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+
+		fmt.Printf("%s price is %.6f (confidence %.2f, stale %v)\n", onedex.OneToken, price.Price, price.Confidence, price.Stale)
 	}
 }
 
@@ -931,8 +931,7 @@ func (contract *OneDex) SetPairInactive(_pk []byte, _value float64, _gasLimit ui
 }
 
 func (contract *OneDex) AddInitialLiquidity(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64) error {
-    dataField := hex.EncodeToString([]byte("addInitialLiquidity"))
-    hash, err := contract.netMan.SendEsdtTransaction(_pk, contract.contractAddress, _value, _gasLimit, _token, dataField, _nonce)
+    hash, err := contract.netMan.SendEsdtTransaction(_pk, contract.contractAddress, _value, _gasLimit, _token, "addInitialLiquidity", nil, _nonce)
     if err != nil {
         return err
     }
@@ -949,8 +948,7 @@ func (contract *OneDex) AddLiquidity(_pk []byte, _value float64, _gasLimit uint6
     _args := make([]string, 0)
     _args = append(_args, hex.EncodeToString(first_token_amount_min.Bytes()))
     _args = append(_args, hex.EncodeToString(second_token_amount_min.Bytes()))
-    dataField := hex.EncodeToString([]byte("addLiquidity")) + "@" + strings.Join(_args, "@")
-    hash, err := contract.netMan.SendEsdtTransaction(_pk, contract.contractAddress, _value, _gasLimit, _token, dataField, _nonce)
+    hash, err := contract.netMan.SendEsdtTransaction(_pk, contract.contractAddress, _value, _gasLimit, _token, "addLiquidity", _args, _nonce)
     if err != nil {
         return err
     }
@@ -968,8 +966,7 @@ func (contract *OneDex) RemoveLiquidity(_pk []byte, _value float64, _gasLimit ui
     _args = append(_args, hex.EncodeToString(first_token_amount_min.Bytes()))
     _args = append(_args, hex.EncodeToString(second_token_amount_min.Bytes()))
     if unwrap_required {_args = append(_args, "01") } else {_args = append(_args, "00")}
-    dataField := hex.EncodeToString([]byte("removeLiquidity")) + "@" + strings.Join(_args, "@")
-    hash, err := contract.netMan.SendEsdtTransaction(_pk, contract.contractAddress, _value, _gasLimit, _token, dataField, _nonce)
+    hash, err := contract.netMan.SendEsdtTransaction(_pk, contract.contractAddress, _value, _gasLimit, _token, "removeLiquidity", _args, _nonce)
     if err != nil {
         return err
     }
@@ -989,8 +986,7 @@ func (contract *OneDex) SwapMultiTokensFixedInput(_pk []byte, _value float64, _g
     for _, elem := range path_args {
         _args = append(_args, hex.EncodeToString([]byte(elem)))
     }
-    dataField := hex.EncodeToString([]byte("swapMultiTokensFixedInput")) + "@" + strings.Join(_args, "@")
-    hash, err := contract.netMan.SendEsdtTransaction(_pk, contract.contractAddress, _value, _gasLimit, _token, dataField, _nonce)
+    hash, err := contract.netMan.SendEsdtTransaction(_pk, contract.contractAddress, _value, _gasLimit, _token, "swapMultiTokensFixedInput", _args, _nonce)
     if err != nil {
         return err
     }
@@ -1010,8 +1006,7 @@ func (contract *OneDex) SwapMultiTokensFixedOutput(_pk []byte, _value float64, _
     for _, elem := range path_args {
         _args = append(_args, hex.EncodeToString([]byte(elem)))
     }
-    dataField := hex.EncodeToString([]byte("swapMultiTokensFixedOutput")) + "@" + strings.Join(_args, "@")
-    hash, err := contract.netMan.SendEsdtTransaction(_pk, contract.contractAddress, _value, _gasLimit, _token, dataField, _nonce)
+    hash, err := contract.netMan.SendEsdtTransaction(_pk, contract.contractAddress, _value, _gasLimit, _token, "swapMultiTokensFixedOutput", _args, _nonce)
     if err != nil {
         return err
     }
@@ -52,7 +52,7 @@ func main() {
 		return
 	}
 
-	hash, err = netMan.SendEsdtTransaction(privateKey, receiver, 1, utils.AutoGasLimit, token, "", utils.AutoNonce)
+	hash, err = netMan.SendEsdtTransaction(privateKey, receiver, 1, utils.AutoGasLimit, token, "", nil, utils.AutoNonce)
 	if err != nil {
 		fmt.Println(err)
 		return
@@ -0,0 +1,226 @@
+package analytics
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/stakingagency/sa-mx-sdk-go/data"
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+const (
+	pricePrecision = 18
+
+	// fees of both xExchange pairs and OneDex pools are expressed in this denomination
+	FeeDenominator = 100000
+)
+
+var (
+	priceScale   = big.NewInt(0).Exp(big.NewInt(10), big.NewInt(pricePrecision), nil)
+	percentScale = big.NewInt(1000000)
+)
+
+func GetAmountOut(reserveIn *big.Int, reserveOut *big.Int, amountIn *big.Int, fee *big.Int, feeDenominator *big.Int) (*big.Int, error) {
+	if amountIn.Sign() <= 0 || reserveIn.Sign() == 0 || reserveOut.Sign() == 0 {
+		return nil, utils.ErrInsufficientLiquidity
+	}
+
+	amountInWithFee := big.NewInt(0).Mul(amountIn, big.NewInt(0).Sub(feeDenominator, fee))
+	numerator := big.NewInt(0).Mul(amountInWithFee, reserveOut)
+	denominator := big.NewInt(0).Mul(reserveIn, feeDenominator)
+	denominator.Add(denominator, amountInWithFee)
+
+	return numerator.Quo(numerator, denominator), nil
+}
+
+func GetAmountIn(reserveIn *big.Int, reserveOut *big.Int, amountOut *big.Int, fee *big.Int, feeDenominator *big.Int) (*big.Int, error) {
+	if amountOut.Sign() <= 0 || amountOut.Cmp(reserveOut) >= 0 || reserveIn.Sign() == 0 {
+		return nil, utils.ErrInsufficientLiquidity
+	}
+
+	numerator := big.NewInt(0).Mul(reserveIn, amountOut)
+	numerator.Mul(numerator, feeDenominator)
+	denominator := big.NewInt(0).Sub(reserveOut, amountOut)
+	denominator.Mul(denominator, big.NewInt(0).Sub(feeDenominator, fee))
+	amountIn := numerator.Quo(numerator, denominator)
+
+	return amountIn.Add(amountIn, big.NewInt(1)), nil
+}
+
+func GetPriceImpact(reserveIn *big.Int, reserveOut *big.Int, amountIn *big.Int, fee *big.Int, feeDenominator *big.Int) (float64, error) {
+	amountOut, err := GetAmountOut(reserveIn, reserveOut, amountIn, fee, feeDenominator)
+	if err != nil {
+		return 0, err
+	}
+
+	spotOut := big.NewInt(0).Mul(amountIn, reserveOut)
+	spotOut.Mul(spotOut, big.NewInt(0).Sub(feeDenominator, fee))
+	denominator := big.NewInt(0).Mul(reserveIn, feeDenominator)
+	if spotOut.Sign() == 0 {
+		return 0, utils.ErrInsufficientLiquidity
+	}
+
+	// impact = 1 - amountOut / spotOut, where spotOut is the output at the current price, after fee
+	ratio := big.NewInt(0).Mul(amountOut, denominator)
+	ratio.Mul(ratio, percentScale)
+	ratio.Quo(ratio, spotOut)
+	impact := big.NewInt(0).Sub(percentScale, ratio)
+
+	return scaledToFloat(impact, percentScale) * 100, nil
+}
+
+func GetDepth(reserveIn *big.Int, reserveOut *big.Int, percent float64, fee *big.Int, feeDenominator *big.Int) (*big.Int, error) {
+	if percent <= 0 || percent >= 100 {
+		return nil, utils.ErrInvalidPercent
+	}
+
+	if reserveIn.Sign() == 0 || reserveOut.Sign() == 0 {
+		return nil, utils.ErrInsufficientLiquidity
+	}
+
+	// the price is reserveOut / reserveIn and the product of the reserves is constant, so the new reserveIn
+	// is reserveIn / sqrt(1 - percent)
+	move := big.NewInt(int64(percent * float64(percentScale.Int64()) / 100))
+	newReserveIn := big.NewInt(0).Mul(reserveIn, reserveIn)
+	newReserveIn.Mul(newReserveIn, percentScale)
+	newReserveIn.Quo(newReserveIn, big.NewInt(0).Sub(percentScale, move))
+	newReserveIn.Sqrt(newReserveIn)
+	amountIn := newReserveIn.Sub(newReserveIn, reserveIn)
+	amountIn.Mul(amountIn, feeDenominator)
+
+	return amountIn.Quo(amountIn, big.NewInt(0).Sub(feeDenominator, fee)), nil
+}
+
+func GetTWAP(events []*data.SwapEvent, token1 *data.ESDT, token2 *data.ESDT, from int64, to int64) (float64, error) {
+	// each swap's price (post swap reserves if available, execution price otherwise) is weighted with the time until the next swap
+	sorted := sortEvents(events, from, to)
+	if len(sorted) == 0 || to <= from {
+		return 0, utils.ErrNoSwapEvents
+	}
+
+	sum := big.NewInt(0)
+	totalTime := big.NewInt(0)
+	for i, event := range sorted {
+		price := eventPrice(event, token1, token2)
+		if price == nil {
+			continue
+		}
+
+		end := to
+		if i < len(sorted)-1 {
+			end = sorted[i+1].Timestamp
+		}
+		duration := big.NewInt(end - event.Timestamp)
+		if duration.Sign() == 0 {
+			continue
+		}
+
+		sum.Add(sum, price.Mul(price, duration))
+		totalTime.Add(totalTime, duration)
+	}
+	if totalTime.Sign() == 0 {
+		return lastPrice(sorted, token1, token2)
+	}
+
+	return scaledToFloat(sum.Quo(sum, totalTime), priceScale), nil
+}
+
+func GetVWAP(events []*data.SwapEvent, token1 *data.ESDT, token2 *data.ESDT, from int64, to int64) (float64, error) {
+	sorted := sortEvents(events, from, to)
+	if len(sorted) == 0 {
+		return 0, utils.ErrNoSwapEvents
+	}
+
+	amount1 := big.NewInt(0)
+	amount2 := big.NewInt(0)
+	for _, event := range sorted {
+		if event.TokenIn == token1.Ticker && event.TokenOut == token2.Ticker {
+			amount1.Add(amount1, event.AmountIn)
+			amount2.Add(amount2, event.AmountOut)
+		}
+		if event.TokenIn == token2.Ticker && event.TokenOut == token1.Ticker {
+			amount1.Add(amount1, event.AmountOut)
+			amount2.Add(amount2, event.AmountIn)
+		}
+	}
+	price := getPrice(amount1, amount2, token1, token2)
+	if price == nil {
+		return 0, utils.ErrNoSwapEvents
+	}
+
+	return scaledToFloat(price, priceScale), nil
+}
+
+func GetVolume(events []*data.SwapEvent, token *data.ESDT, from int64, to int64) float64 {
+	volume := big.NewInt(0)
+	for _, event := range sortEvents(events, from, to) {
+		if event.TokenIn == token.Ticker {
+			volume.Add(volume, event.AmountIn)
+		}
+		if event.TokenOut == token.Ticker {
+			volume.Add(volume, event.AmountOut)
+		}
+	}
+
+	return utils.Denominate(volume, int(token.Decimals))
+}
+
+func sortEvents(events []*data.SwapEvent, from int64, to int64) []*data.SwapEvent {
+	res := make([]*data.SwapEvent, 0, len(events))
+	for _, event := range events {
+		if event.Timestamp >= from && event.Timestamp <= to {
+			res = append(res, event)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Timestamp < res[j].Timestamp
+	})
+
+	return res
+}
+
+func eventPrice(event *data.SwapEvent, token1 *data.ESDT, token2 *data.ESDT) *big.Int {
+	amountIn, amountOut := event.AmountIn, event.AmountOut
+	if event.ReserveIn != nil && event.ReserveOut != nil {
+		amountIn, amountOut = event.ReserveIn, event.ReserveOut
+	}
+
+	switch {
+	case event.TokenIn == token1.Ticker && event.TokenOut == token2.Ticker:
+		return getPrice(amountIn, amountOut, token1, token2)
+	case event.TokenIn == token2.Ticker && event.TokenOut == token1.Ticker:
+		return getPrice(amountOut, amountIn, token1, token2)
+	}
+
+	return nil
+}
+
+func lastPrice(events []*data.SwapEvent, token1 *data.ESDT, token2 *data.ESDT) (float64, error) {
+	for i := len(events) - 1; i >= 0; i-- {
+		price := eventPrice(events[i], token1, token2)
+		if price != nil {
+			return scaledToFloat(price, priceScale), nil
+		}
+	}
+
+	return 0, utils.ErrNoSwapEvents
+}
+
+// getPrice returns amount2 / amount1, adjusted with the tokens decimals and scaled with priceScale
+func getPrice(amount1 *big.Int, amount2 *big.Int, token1 *data.ESDT, token2 *data.ESDT) *big.Int {
+	if amount1 == nil || amount2 == nil || amount1.Sign() == 0 {
+		return nil
+	}
+
+	price := big.NewInt(0).Mul(amount2, priceScale)
+	price.Mul(price, big.NewInt(0).Exp(big.NewInt(10), big.NewInt(int64(token1.Decimals)), nil))
+	denominator := big.NewInt(0).Mul(amount1, big.NewInt(0).Exp(big.NewInt(10), big.NewInt(int64(token2.Decimals)), nil))
+
+	return price.Quo(price, denominator)
+}
+
+func scaledToFloat(value *big.Int, scale *big.Int) float64 {
+	res, _ := new(big.Float).Quo(new(big.Float).SetInt(value), new(big.Float).SetInt(scale)).Float64()
+
+	return res
+}
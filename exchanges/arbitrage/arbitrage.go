@@ -0,0 +1,249 @@
+package arbitrage
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/stakingagency/sa-mx-sdk-go/exchanges/onedex"
+	"github.com/stakingagency/sa-mx-sdk-go/exchanges/router"
+	"github.com/stakingagency/sa-mx-sdk-go/exchanges/xexchange"
+	"github.com/stakingagency/sa-mx-sdk-go/network"
+	"github.com/stakingagency/sa-mx-sdk-go/pricing"
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+const (
+	defaultMaxHops   = 3
+	defaultGasPerHop = uint64(20000000)
+	gasPriceModifier = 0.01
+)
+
+type (
+	ArbitrageFoundCallbackFunc func(opportunity *Opportunity)
+)
+
+type Executor interface {
+	ExecuteRoute(pk []byte, route *router.Route, slippage float64) (*router.SwapResult, error)
+}
+
+type Opportunity struct {
+	Key           string
+	Route         *router.Route
+	Token         string
+	AmountIn      float64
+	AmountOut     float64
+	Profit        float64
+	GasCost       float64
+	NetProfit     float64
+	ProfitPercent float64
+	Timestamp     int64
+}
+
+type Scanner struct {
+	netMan          *network.NetworkManager
+	xex             *xexchange.XExchange
+	one             *onedex.OneDex
+	router          *router.Router
+	oracle          *pricing.Oracle
+	refreshInterval time.Duration
+
+	baseTokens []string
+	maxHops    int
+	minProfit  float64
+	gasPerHop  uint64
+
+	dryRun    bool
+	simulator *Simulator
+
+	autoExecutePk       []byte
+	autoExecuteSlippage float64
+
+	cachedOpportunities    map[string]*Opportunity
+	cachedOpportunitiesMut sync.Mutex
+
+	arbitrageFoundCallback ArbitrageFoundCallbackFunc
+}
+
+var log = logger.GetOrCreate("arbitrage")
+
+func NewScanner(netMan *network.NetworkManager, xex *xexchange.XExchange, one *onedex.OneDex, refreshInterval time.Duration) (*Scanner, error) {
+	r, err := router.NewRouter(netMan, xex, one)
+	if err != nil {
+		return nil, err
+	}
+
+	oracle, err := pricing.NewOracle(xex, one, utils.NoRefresh)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Scanner{
+		netMan:          netMan,
+		xex:             xex,
+		one:             one,
+		router:          r,
+		oracle:          oracle,
+		refreshInterval: refreshInterval,
+
+		baseTokens: []string{utils.WEGLD},
+		maxHops:    defaultMaxHops,
+		minProfit:  0,
+		gasPerHop:  defaultGasPerHop,
+
+		dryRun:    false,
+		simulator: NewSimulator(),
+
+		cachedOpportunities: make(map[string]*Opportunity),
+
+		arbitrageFoundCallback: nil,
+	}
+	s.startTasks()
+
+	return s, nil
+}
+
+func (s *Scanner) SetArbitrageFoundCallback(f ArbitrageFoundCallbackFunc) {
+	s.arbitrageFoundCallback = f
+}
+
+func (s *Scanner) SetBaseTokens(tickers []string) {
+	s.baseTokens = tickers
+}
+
+func (s *Scanner) SetMaxHops(maxHops int) {
+	s.maxHops = maxHops
+}
+
+func (s *Scanner) SetMinProfit(percent float64) {
+	s.minProfit = percent
+}
+
+func (s *Scanner) SetGasPerHop(gasLimit uint64) {
+	s.gasPerHop = gasLimit
+}
+
+func (s *Scanner) SetOracle(oracle *pricing.Oracle) {
+	s.oracle = oracle
+}
+
+func (s *Scanner) SetDryRun(dryRun bool) {
+	s.dryRun = dryRun
+}
+
+func (s *Scanner) GetSimulator() *Simulator {
+	return s.simulator
+}
+
+func (s *Scanner) SetAutoExecute(pk []byte, slippage float64) {
+	s.autoExecutePk = pk
+	s.autoExecuteSlippage = slippage
+}
+
+func (s *Scanner) Scan() ([]*Opportunity, error) {
+	pools := router.GetActivePools(s.xex, s.one)
+	s.simulator.Sync(pools)
+	prices, err := s.oracle.GetPrices()
+	if err != nil {
+		log.Warn("get prices", "error", err, "function", "Scan")
+	}
+
+	egldPrice := float64(0)
+	if wegld := prices[utils.WEGLD]; wegld != nil {
+		egldPrice = wegld.Price
+	}
+
+	now := time.Now().Unix()
+	res := make([]*Opportunity, 0)
+	for _, base := range s.baseTokens {
+		gasPrice := float64(1)
+		if base != utils.WEGLD {
+			basePrice := prices[base]
+			if basePrice == nil || basePrice.Price == 0 || egldPrice == 0 {
+				log.Debug("can not convert gas cost", "token", base, "function", "Scan")
+				continue
+			}
+
+			gasPrice = egldPrice / basePrice.Price
+		}
+
+		for _, cycle := range findCycles(pools, base, s.maxHops) {
+			opportunity := sizeCycle(cycle)
+			if opportunity == nil {
+				continue
+			}
+
+			opportunity.GasCost = s.getGasCost(len(cycle)) * gasPrice
+			opportunity.NetProfit = opportunity.Profit - opportunity.GasCost
+			if opportunity.NetProfit <= 0 || opportunity.ProfitPercent < s.minProfit {
+				continue
+			}
+
+			opportunity.Timestamp = now
+			res = append(res, opportunity)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].NetProfit > res[j].NetProfit
+	})
+
+	return res, nil
+}
+
+func (s *Scanner) GetCachedOpportunities() ([]*Opportunity, error) {
+	if s.refreshInterval == utils.NoRefresh {
+		return nil, utils.ErrRefreshIntervalNotSet
+	}
+
+	s.cachedOpportunitiesMut.Lock()
+	res := make([]*Opportunity, 0, len(s.cachedOpportunities))
+	for _, opportunity := range s.cachedOpportunities {
+		res = append(res, opportunity)
+	}
+	s.cachedOpportunitiesMut.Unlock()
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].NetProfit > res[j].NetProfit
+	})
+
+	return res, nil
+}
+
+func (s *Scanner) Execute(pk []byte, opportunity *Opportunity, slippage float64) (*router.SwapResult, error) {
+	if opportunity == nil || opportunity.Route == nil {
+		return nil, utils.ErrInvalidRoute
+	}
+
+	var executor Executor = s.router
+	if s.dryRun {
+		executor = s.simulator
+	}
+
+	return executor.ExecuteRoute(pk, opportunity.Route, slippage)
+}
+
+func (s *Scanner) getGasCost(hops int) float64 {
+	cfg := s.netMan.GetNetworkConfig()
+	if cfg == nil {
+		return 0
+	}
+
+	gasLimit := s.gasPerHop * uint64(hops)
+	if gasLimit < cfg.MinGasLimit {
+		gasLimit = cfg.MinGasLimit
+	}
+	fee := float64(cfg.MinGasLimit) + float64(gasLimit-cfg.MinGasLimit)*gasPriceModifier
+	fee *= float64(cfg.MinGasPrice)
+
+	return fee / 1e18
+}
+
+func getCycleKey(hops []*router.Hop) string {
+	keys := make([]string, 0, len(hops))
+	for _, hop := range hops {
+		keys = append(keys, hop.Dex+":"+hop.Pool+":"+hop.TokenIn.Ticker)
+	}
+
+	return strings.Join(keys, "|")
+}
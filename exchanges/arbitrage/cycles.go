@@ -0,0 +1,148 @@
+package arbitrage
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/stakingagency/sa-mx-sdk-go/data"
+	"github.com/stakingagency/sa-mx-sdk-go/exchanges/router"
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+type cycleHop struct {
+	pool     *router.Pool
+	tokenIn  *data.ESDT
+	tokenOut *data.ESDT
+}
+
+// lists all the cycles starting and ending with the base token, without using a pool or a token twice
+func findCycles(pools []*router.Pool, base string, maxHops int) [][]*cycleHop {
+	graph := make(map[string][]*router.Pool)
+	var token *data.ESDT
+	for _, p := range pools {
+		graph[p.Token1.Ticker] = append(graph[p.Token1.Ticker], p)
+		graph[p.Token2.Ticker] = append(graph[p.Token2.Ticker], p)
+		if p.Token1.Ticker == base {
+			token = p.Token1
+		}
+		if p.Token2.Ticker == base {
+			token = p.Token2
+		}
+	}
+
+	cycles := make([][]*cycleHop, 0)
+	if token == nil {
+		return cycles
+	}
+
+	visitedTokens := make(map[string]bool)
+	visitedPools := make(map[string]bool)
+	var search func(current *data.ESDT, hops []*cycleHop)
+	search = func(current *data.ESDT, hops []*cycleHop) {
+		for _, p := range graph[current.Ticker] {
+			if visitedPools[p.GetKey()] {
+				continue
+			}
+
+			next := p.Token2
+			if p.Token2.Ticker == current.Ticker {
+				next = p.Token1
+			}
+			path := append(append(make([]*cycleHop, 0, len(hops)+1), hops...), &cycleHop{pool: p, tokenIn: current, tokenOut: next})
+			if next.Ticker == base {
+				if len(path) > 1 {
+					cycles = append(cycles, path)
+				}
+				continue
+			}
+
+			if visitedTokens[next.Ticker] || len(path) >= maxHops {
+				continue
+			}
+
+			visitedTokens[next.Ticker] = true
+			visitedPools[p.GetKey()] = true
+			search(next, path)
+			visitedTokens[next.Ticker] = false
+			visitedPools[p.GetKey()] = false
+		}
+	}
+	search(token, make([]*cycleHop, 0))
+
+	return cycles
+}
+
+// a constant product swap is out = a*x / (b + c*x), with a = gamma*reserveOut, b = reserveIn and c = gamma,
+// where gamma is 1 - fee. Chaining swaps keeps the same form, so the whole cycle's profit a*x / (b + c*x) - x
+// is maximized for x = (sqrt(a*b) - b) / c, and there is a profit only if a > b
+func sizeCycle(cycle []*cycleHop) *Opportunity {
+	a, b, c := float64(1), float64(1), float64(0)
+	for i, hop := range cycle {
+		reserveIn, reserveOut := hop.pool.GetReserves(hop.tokenIn.Ticker)
+		if reserveIn.Sign() == 0 || reserveOut.Sign() == 0 {
+			return nil
+		}
+
+		gamma := hop.pool.GetFeeFactor()
+		fReserveIn, _ := new(big.Float).SetInt(reserveIn).Float64()
+		fReserveOut, _ := new(big.Float).SetInt(reserveOut).Float64()
+		if i == 0 {
+			a, b, c = gamma*fReserveOut, fReserveIn, gamma
+			continue
+		}
+
+		a, b, c = a*gamma*fReserveOut, b*fReserveIn, fReserveIn*c+gamma*a
+	}
+	if a <= b || c == 0 {
+		return nil
+	}
+
+	optimal := (math.Sqrt(a)*math.Sqrt(b) - b) / c
+	amountIn, _ := big.NewFloat(optimal).Int(nil)
+	if amountIn.Sign() <= 0 {
+		return nil
+	}
+
+	hops := make([]*router.Hop, 0, len(cycle))
+	amount := amountIn
+	for _, hop := range cycle {
+		amountOut := hop.pool.GetAmountOut(hop.tokenIn.Ticker, amount)
+		if amountOut.Sign() <= 0 {
+			return nil
+		}
+
+		hops = append(hops, &router.Hop{
+			Dex:       hop.pool.Dex,
+			Pool:      hop.pool.ID,
+			TokenIn:   hop.tokenIn,
+			TokenOut:  hop.tokenOut,
+			AmountIn:  amount,
+			AmountOut: amountOut,
+		})
+		amount = amountOut
+	}
+	if amount.Cmp(amountIn) <= 0 {
+		return nil
+	}
+
+	token := cycle[0].tokenIn
+	decimals := int(token.Decimals)
+	route := &router.Route{
+		TokenIn:   token.Ticker,
+		TokenOut:  token.Ticker,
+		AmountIn:  utils.Denominate(amountIn, decimals),
+		AmountOut: utils.Denominate(amount, decimals),
+		Hops:      hops,
+	}
+	opportunity := &Opportunity{
+		Key:       getCycleKey(hops),
+		Route:     route,
+		Token:     token.Ticker,
+		AmountIn:  route.AmountIn,
+		AmountOut: route.AmountOut,
+		Profit:    route.AmountOut - route.AmountIn,
+	}
+	opportunity.ProfitPercent = opportunity.Profit / opportunity.AmountIn * 100
+
+	return opportunity
+}
@@ -0,0 +1,86 @@
+package arbitrage
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/stakingagency/sa-mx-sdk-go/exchanges/router"
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+// Simulator is a local stand-in for the DEXes, used for dry runs. It executes routes against a copy of the
+// pools' reserves, which is updated after each simulated swap and synced with the chain on every scan
+type Simulator struct {
+	pools    map[string]*router.Pool
+	poolsMut sync.Mutex
+}
+
+func NewSimulator() *Simulator {
+	return &Simulator{
+		pools: make(map[string]*router.Pool),
+	}
+}
+
+func (sim *Simulator) Sync(pools []*router.Pool) {
+	sim.poolsMut.Lock()
+	sim.pools = make(map[string]*router.Pool, len(pools))
+	for _, p := range pools {
+		simPool := *p
+		simPool.Reserve1 = big.NewInt(0).Set(p.Reserve1)
+		simPool.Reserve2 = big.NewInt(0).Set(p.Reserve2)
+		sim.pools[p.GetKey()] = &simPool
+	}
+	sim.poolsMut.Unlock()
+}
+
+func (sim *Simulator) ExecuteRoute(pk []byte, route *router.Route, slippage float64) (*router.SwapResult, error) {
+	if route == nil || len(route.Hops) == 0 {
+		return nil, utils.ErrInvalidRoute
+	}
+
+	if slippage < 0 || slippage >= 100 {
+		return nil, utils.ErrInvalidSlippage
+	}
+
+	sim.poolsMut.Lock()
+	defer sim.poolsMut.Unlock()
+
+	pools := make([]*router.Pool, 0, len(route.Hops))
+	amounts := make([]*big.Int, 0, len(route.Hops))
+	amount := route.Hops[0].AmountIn
+	for _, hop := range route.Hops {
+		p := sim.pools[hop.Dex+":"+hop.Pool]
+		if p == nil {
+			return nil, utils.ErrPairNotFound
+		}
+
+		amount = p.GetAmountOut(hop.TokenIn.Ticker, amount)
+		if amount.Sign() <= 0 {
+			return nil, utils.ErrInsufficientLiquidity
+		}
+
+		pools = append(pools, p)
+		amounts = append(amounts, amount)
+	}
+
+	last := route.Hops[len(route.Hops)-1]
+	minOut := utils.ApplySlippage(last.AmountOut, slippage, false)
+	if amount.Cmp(minOut) < 0 {
+		return nil, utils.ErrSlippageExceeded
+	}
+
+	// the reserves are only updated if the whole route succeeds
+	amountIn := route.Hops[0].AmountIn
+	for i, p := range pools {
+		reserveIn, reserveOut := p.GetReserves(route.Hops[i].TokenIn.Ticker)
+		reserveIn.Add(reserveIn, amountIn)
+		reserveOut.Sub(reserveOut, amounts[i])
+		amountIn = amounts[i]
+	}
+
+	return &router.SwapResult{
+		Route:     route,
+		TxHashes:  make([]string, 0),
+		AmountOut: utils.Denominate(amount, int(last.TokenOut.Decimals)),
+	}, nil
+}
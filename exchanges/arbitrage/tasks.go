@@ -0,0 +1,68 @@
+package arbitrage
+
+import (
+	"time"
+
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+func (s *Scanner) startTasks() {
+	if s.refreshInterval == utils.NoRefresh {
+		return
+	}
+
+	go func() {
+		for {
+			startTime := time.Now().UnixNano()
+
+			s.refreshOpportunities()
+
+			endTime := time.Now().UnixNano()
+			waitTime := s.refreshInterval - time.Duration(endTime-startTime)
+			if waitTime > 0 {
+				time.Sleep(waitTime)
+			}
+		}
+	}()
+}
+
+func (s *Scanner) refreshOpportunities() {
+	opportunities, err := s.Scan()
+	if err != nil {
+		log.Error("scan", "error", err, "function", "refreshOpportunities")
+		return
+	}
+
+	newOpportunities := make(map[string]*Opportunity)
+	for _, opportunity := range opportunities {
+		newOpportunities[opportunity.Key] = opportunity
+	}
+
+	s.cachedOpportunitiesMut.Lock()
+	oldOpportunities := s.cachedOpportunities
+	s.cachedOpportunities = newOpportunities
+	s.cachedOpportunitiesMut.Unlock()
+
+	for _, opportunity := range opportunities {
+		if oldOpportunities[opportunity.Key] != nil {
+			continue
+		}
+
+		if s.arbitrageFoundCallback != nil {
+			s.arbitrageFoundCallback(opportunity)
+		}
+
+		if s.autoExecutePk == nil {
+			continue
+		}
+
+		result, err := s.Execute(s.autoExecutePk, opportunity, s.autoExecuteSlippage)
+		if err != nil {
+			log.Warn("execute opportunity", "error", err, "route", opportunity.Key, "dry run", s.dryRun, "function", "refreshOpportunities")
+			continue
+		}
+
+		log.Info("opportunity executed", "route", opportunity.Key, "amount in", opportunity.AmountIn,
+			"amount out", result.AmountOut, "dry run", s.dryRun, "txs", result.TxHashes)
+	}
+}
@@ -0,0 +1,122 @@
+package onedex
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/stakingagency/sa-mx-sdk-go/data"
+	"github.com/stakingagency/sa-mx-sdk-go/exchanges/analytics"
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+func (one *OneDex) GetSwapEvents(lp *LiquidityPool, from time.Time, to time.Time) ([]*data.SwapEvent, error) {
+	if lp.Token1 == nil || lp.Token2 == nil {
+		return nil, utils.ErrTokenNotInPair
+	}
+
+	query := map[string]interface{}{
+		"bool": map[string]interface{}{
+			"must": []interface{}{
+				map[string]interface{}{"match": map[string]string{"receiver": liquidityPoolSC}},
+				map[string]interface{}{"match": map[string]string{"status": "success"}},
+				map[string]interface{}{"terms": map[string][]string{"function": {"swapMultiTokensFixedInput", "swapMultiTokensFixedOutput"}}},
+				map[string]interface{}{"range": map[string]interface{}{"timestamp": map[string]int64{"gte": from.Unix(), "lte": to.Unix()}}},
+			},
+		},
+	}
+	txs, err := one.netMan.SearchIndexer("transactions", query, nil)
+	if err != nil {
+		log.Error("search indexer", "error", err, "function", "GetSwapEvents")
+		return nil, err
+	}
+
+	res := make([]*data.SwapEvent, 0)
+	for _, tx := range txs {
+		tokenIn, amountIn, tokenOut, ok := parseSwapData(string(tx.Source.Data))
+		if !ok {
+			continue
+		}
+
+		if !(tokenIn == lp.Token1.Ticker && tokenOut == lp.Token2.Ticker) &&
+			!(tokenIn == lp.Token2.Ticker && tokenOut == lp.Token1.Ticker) {
+			continue
+		}
+
+		received, err := one.netMan.GetTxReceivedAmounts(tx.Hash, tx.Source.Sender)
+		if err != nil {
+			log.Debug("get received amounts", "error", err, "hash", tx.Hash, "function", "GetSwapEvents")
+			continue
+		}
+
+		amountOut := received[tokenOut]
+		if amountOut == nil || amountOut.Sign() == 0 {
+			continue
+		}
+
+		// fixed output swaps refund the unused input amount
+		if refund := received[tokenIn]; refund != nil {
+			amountIn.Sub(amountIn, refund)
+		}
+
+		res = append(res, &data.SwapEvent{
+			TxHash:    tx.Hash,
+			Timestamp: tx.Source.Timestamp,
+			TokenIn:   tokenIn,
+			TokenOut:  tokenOut,
+			AmountIn:  amountIn,
+			AmountOut: amountOut,
+		})
+	}
+
+	return res, nil
+}
+
+func (one *OneDex) GetTWAP(lp *LiquidityPool, window time.Duration) (float64, error) {
+	to := time.Now()
+	from := to.Add(-window)
+	events, err := one.GetSwapEvents(lp, from, to)
+	if err != nil {
+		return 0, err
+	}
+
+	return analytics.GetTWAP(events, lp.Token1, lp.Token2, from.Unix(), to.Unix())
+}
+
+func (one *OneDex) GetVWAP(lp *LiquidityPool, window time.Duration) (float64, error) {
+	to := time.Now()
+	from := to.Add(-window)
+	events, err := one.GetSwapEvents(lp, from, to)
+	if err != nil {
+		return 0, err
+	}
+
+	return analytics.GetVWAP(events, lp.Token1, lp.Token2, from.Unix(), to.Unix())
+}
+
+// ESDTTransfer@tokenIn@amountIn@function@amount@unwrap@path...
+// only single pool swaps are returned
+func parseSwapData(dataField string) (string, *big.Int, string, bool) {
+	args := strings.Split(dataField, "@")
+	if len(args) != 8 || args[0] != "ESDTTransfer" {
+		return "", nil, "", false
+	}
+
+	decoded := make([][]byte, len(args))
+	for i := 1; i < len(args); i++ {
+		bytes, err := hex.DecodeString(args[i])
+		if err != nil {
+			return "", nil, "", false
+		}
+
+		decoded[i] = bytes
+	}
+
+	tokenIn := string(decoded[1])
+	if string(decoded[6]) != tokenIn {
+		return "", nil, "", false
+	}
+
+	return tokenIn, big.NewInt(0).SetBytes(decoded[2]), string(decoded[7]), true
+}
@@ -0,0 +1,268 @@
+package onedex
+
+import (
+	"encoding/hex"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/stakingagency/sa-mx-sdk-go/accounts"
+	"github.com/stakingagency/sa-mx-sdk-go/network"
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+const (
+	boostedStakeGasLimit = uint64(20000000)
+	sftDecimals          = 0
+)
+
+func (one *OneDex) SetBoostedStakingContract(contractAddress string) {
+	one.boostedStakingSC = contractAddress
+}
+
+func (one *OneDex) GetBoostedStake() (*BoostedStake, error) {
+	if one.boostedStakingSC == "" {
+		return nil, utils.ErrContractNotSet
+	}
+
+	stake := &BoostedStake{
+		ContractAddress: one.boostedStakingSC,
+		Stakers:         make([]*Staker, 0),
+	}
+
+	res, err := one.netMan.QuerySC(one.boostedStakingSC, "getSftId", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res.Data.ReturnData) > 0 {
+		stake.SftID = string(res.Data.ReturnData[0])
+	}
+
+	// the SFT nonce is only available in the contract's storage
+	account, err := accounts.NewAccount(one.boostedStakingSC, one.netMan, utils.NoRefresh)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := account.GetAccountKey(hex.EncodeToString([]byte("sft_nonce")))
+	if err != nil {
+		return nil, err
+	}
+
+	stake.SftNonce = big.NewInt(0).SetBytes(nonce).Uint64()
+	stake.RewardToken, err = one.getToken(OneToken)
+	if err != nil {
+		return nil, err
+	}
+
+	apr, err := one.netMan.QueryScIntResult(one.boostedStakingSC, "getAPR", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	stake.APR = float64(apr.Uint64()) / 100
+	minStake, err := one.netMan.QueryScIntResult(one.boostedStakingSC, "getMinStakeAmount", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	stake.MinStake = utils.Denominate(minStake, sftDecimals)
+	totalStake, err := one.netMan.QueryScIntResult(one.boostedStakingSC, "getTotalStaking", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	stake.TotalStake = utils.Denominate(totalStake, sftDecimals)
+	rewardPool, err := one.netMan.QueryScIntResult(one.boostedStakingSC, "getDepositedRewards", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	stake.RewardPool = utils.Denominate(rewardPool, int(stake.RewardToken.Decimals))
+	users, err := one.GetBoostedStakeUsers()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, address := range users {
+		staker, err := one.GetBoostedStaker(address)
+		if err != nil {
+			log.Debug("get boosted staker", "error", err, "address", address, "function", "GetBoostedStake")
+			continue
+		}
+
+		stake.Stakers = append(stake.Stakers, staker)
+	}
+
+	return stake, nil
+}
+
+func (one *OneDex) GetCachedBoostedStake() (*BoostedStake, error) {
+	if one.refreshInterval == utils.NoRefresh {
+		return nil, utils.ErrRefreshIntervalNotSet
+	}
+
+	one.boostedStakeMut.Lock()
+	defer one.boostedStakeMut.Unlock()
+
+	if one.boostedStake == nil {
+		return nil, utils.ErrContractNotSet
+	}
+
+	res := *one.boostedStake
+	res.Stakers = make([]*Staker, 0, len(one.boostedStake.Stakers))
+	for _, staker := range one.boostedStake.Stakers {
+		stakerCopy := *staker
+		res.Stakers = append(res.Stakers, &stakerCopy)
+	}
+
+	return &res, nil
+}
+
+func (one *OneDex) GetBoostedStakeUsers() ([]string, error) {
+	if one.boostedStakingSC == "" {
+		return nil, utils.ErrContractNotSet
+	}
+
+	res, err := one.netMan.QuerySC(one.boostedStakingSC, "getUserList", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	conv, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	users := make([]string, 0)
+	for _, bytes := range res.Data.ReturnData {
+		address, err := conv.Encode(bytes)
+		if err != nil {
+			return nil, err
+		}
+
+		users = append(users, address)
+	}
+
+	return users, nil
+}
+
+// the reward is the current reward amount, including the rewards accumulated since the last update
+func (one *OneDex) GetBoostedStaker(address string) (*Staker, error) {
+	if one.boostedStakingSC == "" {
+		return nil, utils.ErrContractNotSet
+	}
+
+	sAddress, err := utils.AddressArg(address)
+	if err != nil {
+		return nil, err
+	}
+
+	rewardToken, err := one.getToken(OneToken)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := one.netMan.QuerySC(one.boostedStakingSC, "getUserStakingInfo", []string{sAddress})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res.Data.ReturnData) == 0 {
+		return nil, utils.ErrInvalidResponse
+	}
+
+	amount, _, ok := utils.ParseBigInt(res.Data.ReturnData[0], 0)
+	if !ok {
+		return nil, utils.ErrInvalidResponse
+	}
+
+	reward, err := one.netMan.QueryScIntResult(one.boostedStakingSC, "getCurrentUserRewardAmount", []string{sAddress})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Staker{
+		Address: address,
+		Amount:  utils.Denominate(amount, sftDecimals),
+		Reward:  utils.Denominate(reward, int(rewardToken.Decimals)),
+	}, nil
+}
+
+func (one *OneDex) StakeSft(pk []byte, stake *BoostedStake, amount uint64) error {
+	if amount == 0 {
+		return utils.ErrInvalidAmount
+	}
+
+	if float64(amount) < stake.MinStake {
+		return utils.ErrBelowMinStake
+	}
+
+	hash, err := one.netMan.SendEsdtNftTransaction(pk, stake.ContractAddress, stake.SftID, stake.SftNonce, big.NewInt(int64(amount)), boostedStakeGasLimit, "stake", nil, utils.AutoNonce)
+	if err != nil {
+		return err
+	}
+
+	return one.netMan.GetTxResult(hash)
+}
+
+// an amount of 0 unstakes everything
+func (one *OneDex) UnstakeSft(pk []byte, stake *BoostedStake, amount uint64) error {
+	staker, err := getBoostedStaker(pk, stake)
+	if err != nil {
+		return err
+	}
+
+	if staker == nil || staker.Amount == 0 || staker.Amount < float64(amount) {
+		return utils.ErrInsufficientStake
+	}
+
+	dataField := "unstake"
+	if amount > 0 {
+		dataField += "@" + utils.Uint64Arg(amount)
+	}
+	hash, err := one.netMan.SendTransaction(pk, stake.ContractAddress, 0, boostedStakeGasLimit, dataField, utils.AutoNonce)
+	if err != nil {
+		return err
+	}
+
+	return one.netMan.GetTxResult(hash)
+}
+
+func (one *OneDex) ClaimBoostedStakeRewards(pk []byte, stake *BoostedStake) (float64, error) {
+	staker, err := getBoostedStaker(pk, stake)
+	if err != nil {
+		return 0, err
+	}
+
+	if staker == nil || staker.Reward == 0 || stake.RewardPool == 0 {
+		return 0, utils.ErrNothingToClaim
+	}
+
+	hash, err := one.netMan.SendTransaction(pk, stake.ContractAddress, 0, boostedStakeGasLimit, "claim", utils.AutoNonce)
+	if err != nil {
+		return 0, err
+	}
+
+	received, err := one.getReceivedAmounts(pk, hash)
+	if err != nil {
+		return 0, err
+	}
+
+	if received[stake.RewardToken.Ticker] == nil {
+		return 0, utils.ErrEventNotFound
+	}
+
+	return utils.Denominate(received[stake.RewardToken.Ticker], int(stake.RewardToken.Decimals)), nil
+}
+
+func getBoostedStaker(pk []byte, stake *BoostedStake) (*Staker, error) {
+	address, err := network.GetAddressFromPrivateKey(pk)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, staker := range stake.Stakers {
+		if staker.Address == address {
+			return staker, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,142 @@
+package onedex
+
+import (
+	"github.com/stakingagency/sa-mx-sdk-go/network"
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+const farmGasLimit = uint64(20000000)
+
+type FarmResult struct {
+	TxHash  string
+	FarmID  uint32
+	Amount  float64
+	Reward1 float64
+	Reward2 float64
+}
+
+func (one *OneDex) EnterFarm(pk []byte, farm *Farm, amount float64) (*FarmResult, error) {
+	if farm.LpToken == nil {
+		return nil, utils.ErrTokenNotInPair
+	}
+
+	iAmount := utils.Renominate(amount, int(farm.LpToken.Decimals))
+	if iAmount.Sign() <= 0 {
+		return nil, utils.ErrInvalidAmount
+	}
+
+	args := []string{utils.Uint64Arg(uint64(farm.ID))}
+	hash, err := one.netMan.SendEsdtTransaction(pk, farmSC, amount, farmGasLimit, farm.LpToken, "userStake", args, utils.AutoNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &FarmResult{
+		TxHash: hash,
+		FarmID: farm.ID,
+		Amount: amount,
+	}
+	err = one.getFarmRewardsReceived(pk, farm, result)
+
+	return result, err
+}
+
+func (one *OneDex) ExitFarm(pk []byte, farm *Farm, amount float64) (*FarmResult, error) {
+	if farm.LpToken == nil {
+		return nil, utils.ErrTokenNotInPair
+	}
+
+	iAmount := utils.Renominate(amount, int(farm.LpToken.Decimals))
+	if iAmount.Sign() <= 0 {
+		return nil, utils.ErrInvalidAmount
+	}
+
+	farmer, err := getFarmer(pk, farm)
+	if err != nil {
+		return nil, err
+	}
+
+	if farmer == nil || farmer.Amount < amount {
+		return nil, utils.ErrInsufficientStake
+	}
+
+	dataField := "userUnstake@" + utils.Uint64Arg(uint64(farm.ID)) + "@" + utils.BigIntArg(iAmount)
+	hash, err := one.netMan.SendTransaction(pk, farmSC, 0, farmGasLimit, dataField, utils.AutoNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &FarmResult{
+		TxHash: hash,
+		FarmID: farm.ID,
+		Amount: amount,
+	}
+	err = one.getFarmRewardsReceived(pk, farm, result)
+
+	return result, err
+}
+
+func (one *OneDex) ClaimFarmRewards(pk []byte, farm *Farm) (*FarmResult, error) {
+	farmer, err := getFarmer(pk, farm)
+	if err != nil {
+		return nil, err
+	}
+
+	if farmer == nil || farmer.Amount == 0 || (farm.RewardPool1 == 0 && farm.RewardPool2 == 0) {
+		return nil, utils.ErrNothingToClaim
+	}
+
+	reward1, reward2, err := one.GetUserFarmRewards(farmer.Address, farm)
+	if err != nil {
+		return nil, err
+	}
+
+	if reward1 == 0 && reward2 == 0 {
+		return nil, utils.ErrNothingToClaim
+	}
+
+	hash, err := one.netMan.SendTransaction(pk, farmSC, 0, farmGasLimit, "userClaim@"+utils.Uint64Arg(uint64(farm.ID)), utils.AutoNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &FarmResult{
+		TxHash: hash,
+		FarmID: farm.ID,
+	}
+	err = one.getFarmRewardsReceived(pk, farm, result)
+
+	return result, err
+}
+
+// the farm sends the pending rewards on every stake, unstake and claim
+func (one *OneDex) getFarmRewardsReceived(pk []byte, farm *Farm, result *FarmResult) error {
+	received, err := one.getReceivedAmounts(pk, result.TxHash)
+	if err != nil {
+		return err
+	}
+
+	if farm.RewardToken1 != nil && received[farm.RewardToken1.Ticker] != nil {
+		result.Reward1 = utils.Denominate(received[farm.RewardToken1.Ticker], int(farm.RewardToken1.Decimals))
+	}
+	if farm.RewardToken2 != nil && received[farm.RewardToken2.Ticker] != nil {
+		result.Reward2 = utils.Denominate(received[farm.RewardToken2.Ticker], int(farm.RewardToken2.Decimals))
+	}
+
+	return nil
+}
+
+func getFarmer(pk []byte, farm *Farm) (*Farmer, error) {
+	address, err := network.GetAddressFromPrivateKey(pk)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, farmer := range farm.Farmers {
+		if farmer.Address == address {
+			return farmer, nil
+		}
+	}
+
+	return nil, nil
+}
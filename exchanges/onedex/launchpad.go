@@ -11,12 +11,17 @@ type Launchpad struct {
 	Twitter     string
 	Website     string
 	HardCap     float64
+	MinBuy      float64
+	MaxBuy      float64
 	TotalBought float64
 	FundAmount  float64
 	Token       string
 	FundToken   string
 	Rate        float64
 	Buyers      []*LaunchpadBuyer
+
+	Started        bool
+	HardCapReached bool
 }
 
 type LaunchpadBuyer struct {
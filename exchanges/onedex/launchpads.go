@@ -0,0 +1,165 @@
+package onedex
+
+import (
+	"math"
+	"time"
+
+	"github.com/stakingagency/sa-mx-sdk-go/network"
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+const launchpadGasLimit = uint64(20000000)
+
+type LaunchpadBuyResult struct {
+	TxHash string
+	Paid   float64
+	Bought float64
+}
+
+type LaunchpadAllocation struct {
+	Launchpad    *Launchpad
+	Bought       float64
+	Paid         float64
+	RemainingBuy float64
+}
+
+// the amount is in fund tokens. The presale tokens are sent by the contract when buying, there is nothing to claim afterwards
+func (one *OneDex) BuyLaunchpad(pk []byte, launchpad *Launchpad, amount float64) (*LaunchpadBuyResult, error) {
+	now := time.Now().Unix()
+	if !launchpad.IsLive || now < launchpad.StartTime || now >= launchpad.EndTime {
+		return nil, utils.ErrLaunchpadNotLive
+	}
+
+	if amount <= 0 || launchpad.Rate <= 0 {
+		return nil, utils.ErrInvalidAmount
+	}
+
+	if launchpad.HardCapReached || launchpad.FundAmount+amount > launchpad.HardCap {
+		return nil, utils.ErrHardCapReached
+	}
+
+	if amount < launchpad.MinBuy {
+		return nil, utils.ErrBelowMinBuy
+	}
+
+	address, err := network.GetAddressFromPrivateKey(pk)
+	if err != nil {
+		return nil, err
+	}
+
+	allocation, err := one.GetUserLaunchpadAllocation(address, launchpad)
+	if err != nil {
+		return nil, err
+	}
+
+	if amount > allocation.RemainingBuy {
+		return nil, utils.ErrAboveMaxBuy
+	}
+
+	hash, err := one.sendBuy(pk, launchpad, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &LaunchpadBuyResult{
+		TxHash: hash,
+		Paid:   amount,
+		Bought: amount * launchpad.Rate,
+	}
+	received, err := one.getReceivedAmounts(pk, hash)
+	if err != nil {
+		return result, err
+	}
+
+	if received[launchpad.Token] != nil {
+		token, err := one.getToken(launchpad.Token)
+		if err != nil {
+			return result, err
+		}
+
+		result.Bought = utils.Denominate(received[launchpad.Token], int(token.Decimals))
+	}
+
+	return result, nil
+}
+
+func (one *OneDex) sendBuy(pk []byte, launchpad *Launchpad, amount float64) (string, error) {
+	if launchpad.FundToken == "EGLD" {
+		return one.netMan.SendTransaction(pk, launchpadSC, amount, launchpadGasLimit, "buy@"+utils.Uint64Arg(uint64(launchpad.ID)), utils.AutoNonce)
+	}
+
+	fundToken, err := one.getToken(launchpad.FundToken)
+	if err != nil {
+		return "", err
+	}
+
+	args := []string{utils.Uint64Arg(uint64(launchpad.ID))}
+
+	return one.netMan.SendEsdtTransaction(pk, launchpadSC, amount, launchpadGasLimit, fundToken, "buy", args, utils.AutoNonce)
+}
+
+// the remaining buy amount is limited by both the user's maximum buy limit and the launchpad's hard cap
+func (one *OneDex) GetUserLaunchpadAllocation(address string, launchpad *Launchpad) (*LaunchpadAllocation, error) {
+	sAddress, err := utils.AddressArg(address)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := one.getToken(launchpad.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	args := []string{utils.Uint64Arg(uint64(launchpad.ID)), sAddress}
+	bought, err := one.netMan.QueryScIntResult(launchpadSC, "getProjectUserBoughtAmount", args)
+	if err != nil {
+		return nil, err
+	}
+
+	allocation := &LaunchpadAllocation{
+		Launchpad: launchpad,
+		Bought:    utils.Denominate(bought, int(token.Decimals)),
+	}
+	if launchpad.Rate > 0 {
+		allocation.Paid = allocation.Bought / launchpad.Rate
+	}
+	allocation.RemainingBuy = math.Max(launchpad.HardCap-launchpad.FundAmount, 0)
+	if launchpad.MaxBuy > 0 {
+		allocation.RemainingBuy = math.Min(allocation.RemainingBuy, math.Max(launchpad.MaxBuy-allocation.Paid, 0))
+	}
+
+	return allocation, nil
+}
+
+func (one *OneDex) GetUserLaunchpadAllocations(address string) ([]*LaunchpadAllocation, error) {
+	launchpads, err := one.getLaunchpads()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*LaunchpadAllocation, 0)
+	for _, launchpad := range launchpads {
+		for _, buyer := range launchpad.Buyers {
+			if buyer.Address != address {
+				continue
+			}
+
+			allocation, err := one.GetUserLaunchpadAllocation(address, launchpad)
+			if err != nil {
+				return nil, err
+			}
+
+			res = append(res, allocation)
+		}
+	}
+
+	return res, nil
+}
+
+func (one *OneDex) getLaunchpads() (map[uint32]*Launchpad, error) {
+	if one.refreshInterval == utils.NoRefresh {
+		return one.GetLaunchpads()
+	}
+
+	return one.GetCachedLaunchpads()
+}
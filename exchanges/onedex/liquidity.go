@@ -0,0 +1,170 @@
+package onedex
+
+import (
+	"math/big"
+
+	"github.com/stakingagency/sa-mx-sdk-go/data"
+	"github.com/stakingagency/sa-mx-sdk-go/network"
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+const (
+	addLiquidityGasLimit    = uint64(20000000)
+	removeLiquidityGasLimit = uint64(20000000)
+)
+
+func (one *OneDex) GetOptimalLiquidityAmount(lp *LiquidityPool, tokenIn string, amountIn float64) (float64, error) {
+	token, err := lp.GetToken(tokenIn)
+	if err != nil {
+		return 0, err
+	}
+
+	otherToken, _ := lp.GetOtherToken(tokenIn)
+	amountOut, err := lp.GetOptimalAmount(tokenIn, utils.Renominate(amountIn, int(token.Decimals)))
+	if err != nil {
+		return 0, err
+	}
+
+	return utils.Denominate(amountOut, int(otherToken.Decimals)), nil
+}
+
+func (one *OneDex) AddLiquidity(pk []byte, lp *LiquidityPool, amount1 float64, amount2 float64, slippage float64) (*data.LiquidityResult, error) {
+	if !lp.Enabled || lp.State == PairInactive {
+		return nil, utils.ErrPairNotActive
+	}
+
+	if slippage < 0 || slippage >= maxSlippage {
+		return nil, utils.ErrInvalidSlippage
+	}
+
+	if lp.Token1 == nil || lp.Token2 == nil || lp.LpToken == nil {
+		return nil, utils.ErrTokenNotInPair
+	}
+
+	iAmount1 := utils.Renominate(amount1, int(lp.Token1.Decimals))
+	iAmount2 := utils.Renominate(amount2, int(lp.Token2.Decimals))
+	if iAmount1.Sign() <= 0 || iAmount2.Sign() <= 0 {
+		return nil, utils.ErrInvalidAmount
+	}
+
+	optimal2, err := lp.GetOptimalAmount(lp.Token1.Ticker, iAmount1)
+	if err != nil {
+		return nil, err
+	}
+
+	// only the optimal amounts are added to the pool, the rest is returned
+	min1 := iAmount1
+	min2 := optimal2
+	if optimal2.Cmp(iAmount2) > 0 {
+		min1, err = lp.GetOptimalAmount(lp.Token2.Ticker, iAmount2)
+		if err != nil {
+			return nil, err
+		}
+		min2 = iAmount2
+	}
+	min1 = utils.ApplySlippage(min1, slippage, false)
+	min2 = utils.ApplySlippage(min2, slippage, false)
+
+	transfers := []*data.EsdtTransfer{
+		{Ticker: lp.Token1.Ticker, Amount: iAmount1},
+		{Ticker: lp.Token2.Ticker, Amount: iAmount2},
+	}
+	args := []string{utils.BigIntArg(min1), utils.BigIntArg(min2)}
+	hash, err := one.netMan.SendMultiEsdtTransaction(pk, liquidityPoolSC, transfers, addLiquidityGasLimit, "addLiquidity", args, utils.AutoNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &data.LiquidityResult{
+		TxHash:  hash,
+		Pair:    liquidityPoolSC,
+		Token1:  lp.Token1.Ticker,
+		Token2:  lp.Token2.Ticker,
+		LpToken: lp.LpToken.Ticker,
+	}
+	received, err := one.getReceivedAmounts(pk, hash)
+	if err != nil {
+		return result, err
+	}
+
+	if received[lp.Token1.Ticker] != nil {
+		iAmount1 = big.NewInt(0).Sub(iAmount1, received[lp.Token1.Ticker])
+	}
+	if received[lp.Token2.Ticker] != nil {
+		iAmount2 = big.NewInt(0).Sub(iAmount2, received[lp.Token2.Ticker])
+	}
+	result.Amount1 = utils.Denominate(iAmount1, int(lp.Token1.Decimals))
+	result.Amount2 = utils.Denominate(iAmount2, int(lp.Token2.Decimals))
+	if received[lp.LpToken.Ticker] == nil {
+		return result, utils.ErrEventNotFound
+	}
+
+	result.LpAmount = utils.Denominate(received[lp.LpToken.Ticker], int(lp.LpToken.Decimals))
+
+	return result, nil
+}
+
+func (one *OneDex) RemoveLiquidity(pk []byte, lp *LiquidityPool, lpAmount float64, slippage float64) (*data.LiquidityResult, error) {
+	if slippage < 0 || slippage >= maxSlippage {
+		return nil, utils.ErrInvalidSlippage
+	}
+
+	if lp.Token1 == nil || lp.Token2 == nil || lp.LpToken == nil {
+		return nil, utils.ErrTokenNotInPair
+	}
+
+	iLpAmount := utils.Renominate(lpAmount, int(lp.LpToken.Decimals))
+	if iLpAmount.Sign() <= 0 {
+		return nil, utils.ErrInvalidAmount
+	}
+
+	amount1, amount2, err := lp.GetLiquidityAmounts(iLpAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	transfers := []*data.EsdtTransfer{
+		{Ticker: lp.LpToken.Ticker, Amount: iLpAmount},
+	}
+	args := []string{utils.BigIntArg(utils.ApplySlippage(amount1, slippage, false)), utils.BigIntArg(utils.ApplySlippage(amount2, slippage, false)), utils.BoolArg(false)}
+	hash, err := one.netMan.SendMultiEsdtTransaction(pk, liquidityPoolSC, transfers, removeLiquidityGasLimit, "removeLiquidity", args, utils.AutoNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &data.LiquidityResult{
+		TxHash:   hash,
+		Pair:     liquidityPoolSC,
+		Token1:   lp.Token1.Ticker,
+		Token2:   lp.Token2.Ticker,
+		LpToken:  lp.LpToken.Ticker,
+		LpAmount: lpAmount,
+	}
+	received, err := one.getReceivedAmounts(pk, hash)
+	if err != nil {
+		return result, err
+	}
+
+	if received[lp.Token1.Ticker] == nil || received[lp.Token2.Ticker] == nil {
+		return result, utils.ErrEventNotFound
+	}
+
+	result.Amount1 = utils.Denominate(received[lp.Token1.Ticker], int(lp.Token1.Decimals))
+	result.Amount2 = utils.Denominate(received[lp.Token2.Ticker], int(lp.Token2.Decimals))
+
+	return result, nil
+}
+
+func (one *OneDex) getReceivedAmounts(pk []byte, hash string) (map[string]*big.Int, error) {
+	err := one.netMan.GetTxResult(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, err := network.GetAddressFromPrivateKey(pk)
+	if err != nil {
+		return nil, err
+	}
+
+	return one.netMan.GetTxReceivedAmounts(hash, sender)
+}
@@ -1,6 +1,20 @@
 package onedex
 
-import "github.com/stakingagency/sa-mx-sdk-go/data"
+import (
+	"math/big"
+
+	"github.com/stakingagency/sa-mx-sdk-go/data"
+	"github.com/stakingagency/sa-mx-sdk-go/exchanges/analytics"
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+const (
+	PairInactive        = byte(0)
+	PairActive          = byte(1)
+	PairActiveButNoSwap = byte(2)
+)
+
+var feeDenominator = big.NewInt(analytics.FeeDenominator)
 
 type LiquidityPool struct {
 	ID            uint32
@@ -13,4 +27,122 @@ type LiquidityPool struct {
 	Token1Price   float64
 	Enabled       bool
 	State         byte
+	Fee           float64
+
+	RawToken1Reserve *big.Int
+	RawToken2Reserve *big.Int
+	RawLpTokenSupply *big.Int
+}
+
+func (lp *LiquidityPool) CanSwap() bool {
+	return lp.Enabled && lp.State == PairActive
+}
+
+func (lp *LiquidityPool) GetToken(ticker string) (*data.ESDT, error) {
+	if lp.Token1 != nil && lp.Token1.Ticker == ticker {
+		return lp.Token1, nil
+	}
+	if lp.Token2 != nil && lp.Token2.Ticker == ticker {
+		return lp.Token2, nil
+	}
+
+	return nil, utils.ErrTokenNotInPair
+}
+
+func (lp *LiquidityPool) GetOtherToken(ticker string) (*data.ESDT, error) {
+	if lp.Token1 == nil || lp.Token2 == nil {
+		return nil, utils.ErrTokenNotInPair
+	}
+
+	switch ticker {
+	case lp.Token1.Ticker:
+		return lp.Token2, nil
+	case lp.Token2.Ticker:
+		return lp.Token1, nil
+	}
+
+	return nil, utils.ErrTokenNotInPair
+}
+
+func (lp *LiquidityPool) GetAmountOut(tokenIn string, amountIn *big.Int) (*big.Int, error) {
+	reserveIn, reserveOut, err := lp.getReserves(tokenIn)
+	if err != nil {
+		return nil, err
+	}
+
+	return analytics.GetAmountOut(reserveIn, reserveOut, amountIn, lp.GetFee(), feeDenominator)
+}
+
+func (lp *LiquidityPool) GetAmountIn(tokenOut string, amountOut *big.Int) (*big.Int, error) {
+	reserveOut, reserveIn, err := lp.getReserves(tokenOut)
+	if err != nil {
+		return nil, err
+	}
+
+	return analytics.GetAmountIn(reserveIn, reserveOut, amountOut, lp.GetFee(), feeDenominator)
+}
+
+func (lp *LiquidityPool) GetPriceImpact(tokenIn string, amountIn *big.Int) (float64, error) {
+	reserveIn, reserveOut, err := lp.getReserves(tokenIn)
+	if err != nil {
+		return 0, err
+	}
+
+	return analytics.GetPriceImpact(reserveIn, reserveOut, amountIn, lp.GetFee(), feeDenominator)
+}
+
+func (lp *LiquidityPool) GetDepth(tokenIn string, percent float64) (*big.Int, error) {
+	reserveIn, reserveOut, err := lp.getReserves(tokenIn)
+	if err != nil {
+		return nil, err
+	}
+
+	return analytics.GetDepth(reserveIn, reserveOut, percent, lp.GetFee(), feeDenominator)
+}
+
+func (lp *LiquidityPool) getReserves(tokenIn string) (*big.Int, *big.Int, error) {
+	if lp.Token1 == nil || lp.Token2 == nil || lp.RawToken1Reserve == nil || lp.RawToken2Reserve == nil {
+		return nil, nil, utils.ErrInsufficientLiquidity
+	}
+
+	switch tokenIn {
+	case lp.Token1.Ticker:
+		return lp.RawToken1Reserve, lp.RawToken2Reserve, nil
+	case lp.Token2.Ticker:
+		return lp.RawToken2Reserve, lp.RawToken1Reserve, nil
+	}
+
+	return nil, nil, utils.ErrTokenNotInPair
+}
+
+func (lp *LiquidityPool) GetFee() *big.Int {
+	return big.NewInt(int64(lp.Fee * analytics.FeeDenominator / 100))
+}
+
+func (lp *LiquidityPool) GetOptimalAmount(tokenIn string, amountIn *big.Int) (*big.Int, error) {
+	reserveIn, reserveOut, err := lp.getReserves(tokenIn)
+	if err != nil {
+		return nil, err
+	}
+
+	if reserveIn.Sign() == 0 || reserveOut.Sign() == 0 {
+		return nil, utils.ErrInsufficientLiquidity
+	}
+
+	amountOut := big.NewInt(0).Mul(amountIn, reserveOut)
+
+	return amountOut.Quo(amountOut, reserveIn), nil
+}
+
+func (lp *LiquidityPool) GetLiquidityAmounts(lpAmount *big.Int) (*big.Int, *big.Int, error) {
+	if lp.RawLpTokenSupply == nil || lp.RawLpTokenSupply.Sign() == 0 || lp.RawToken1Reserve == nil || lp.RawToken2Reserve == nil {
+		return nil, nil, utils.ErrInsufficientLiquidity
+	}
+
+	amount1 := big.NewInt(0).Mul(lpAmount, lp.RawToken1Reserve)
+	amount1.Quo(amount1, lp.RawLpTokenSupply)
+	amount2 := big.NewInt(0).Mul(lpAmount, lp.RawToken2Reserve)
+	amount2.Quo(amount2, lp.RawLpTokenSupply)
+
+	return amount1, amount2, nil
 }
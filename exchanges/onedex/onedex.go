@@ -28,15 +28,18 @@ const (
 )
 
 type (
-	NewPairCallbackFunc             func(ticker1 string, ticker2 string)
-	PairStateChangedCallbackFunc    func(ticker1 string, ticker2 string, newState bool)
-	NewStakeCallbackFunc            func(ticker string)
-	NewFarmCallbackFunc             func(lpTicker string, rewardTicker string)
-	NewDualFarmCallbackFunc         func(lpTicker string, rewardTicker1 string, rewardTicker2 string)
-	NewLaunchpadCallbackFunc        func(ticker string)
-	LaunchpadEndedCallbackFunc      func(ticker string)
-	AnnualRewardChangedCallbackFunc func(farmID uint32, oldReward float64, newReward float64)
-	StakeAprChangedCallbackFunc     func(stakeID uint32, oldAPR float64, newAPR float64)
+	NewPairCallbackFunc                func(ticker1 string, ticker2 string)
+	PairStateChangedCallbackFunc       func(ticker1 string, ticker2 string, newState bool)
+	NewStakeCallbackFunc               func(ticker string)
+	NewFarmCallbackFunc                func(lpTicker string, rewardTicker string)
+	NewDualFarmCallbackFunc            func(lpTicker string, rewardTicker1 string, rewardTicker2 string)
+	NewLaunchpadCallbackFunc           func(ticker string)
+	LaunchpadEndedCallbackFunc         func(ticker string)
+	LaunchpadStartedCallbackFunc       func(ticker string)
+	HardCapReachedCallbackFunc         func(ticker string)
+	AnnualRewardChangedCallbackFunc    func(farmID uint32, oldReward float64, newReward float64)
+	StakeAprChangedCallbackFunc        func(stakeID uint32, oldAPR float64, newAPR float64)
+	BoostedStakeAprChangedCallbackFunc func(oldAPR float64, newAPR float64)
 )
 
 type OneDex struct {
@@ -56,17 +59,23 @@ type OneDex struct {
 	stakesMut         sync.Mutex
 	launchpads        map[uint32]*Launchpad
 	launchpadsMut     sync.Mutex
-
-	newPairCallback              NewPairCallbackFunc
-	pairStateChangedCallback     PairStateChangedCallbackFunc
-	newStakeCallback             NewStakeCallbackFunc
-	newFarmCallback              NewFarmCallbackFunc
-	newDualFarmCallback          NewDualFarmCallbackFunc
-	newLaunchpadCallback         NewLaunchpadCallbackFunc
-	launchpadEndedCallback       LaunchpadEndedCallbackFunc
-	annualReward1ChangedCallback AnnualRewardChangedCallbackFunc
-	annualReward2ChangedCallback AnnualRewardChangedCallbackFunc
-	stakeAprChangedCallback      StakeAprChangedCallbackFunc
+	boostedStakingSC  string
+	boostedStake      *BoostedStake
+	boostedStakeMut   sync.Mutex
+
+	newPairCallback                NewPairCallbackFunc
+	pairStateChangedCallback       PairStateChangedCallbackFunc
+	newStakeCallback               NewStakeCallbackFunc
+	newFarmCallback                NewFarmCallbackFunc
+	newDualFarmCallback            NewDualFarmCallbackFunc
+	newLaunchpadCallback           NewLaunchpadCallbackFunc
+	launchpadEndedCallback         LaunchpadEndedCallbackFunc
+	launchpadStartedCallback       LaunchpadStartedCallbackFunc
+	hardCapReachedCallback         HardCapReachedCallbackFunc
+	annualReward1ChangedCallback   AnnualRewardChangedCallbackFunc
+	annualReward2ChangedCallback   AnnualRewardChangedCallbackFunc
+	stakeAprChangedCallback        StakeAprChangedCallbackFunc
+	boostedStakeAprChangedCallback BoostedStakeAprChangedCallbackFunc
 }
 
 var log = logger.GetOrCreate("onedex")
@@ -112,16 +121,19 @@ func NewOneDex(netMan *network.NetworkManager, refreshInterval time.Duration) (*
 		stakes:         make(map[uint32]*Stake),
 		launchpads:     make(map[uint32]*Launchpad),
 
-		newPairCallback:              nil,
-		pairStateChangedCallback:     nil,
-		newStakeCallback:             nil,
-		newFarmCallback:              nil,
-		newDualFarmCallback:          nil,
-		newLaunchpadCallback:         nil,
-		launchpadEndedCallback:       nil,
-		annualReward1ChangedCallback: nil,
-		annualReward2ChangedCallback: nil,
-		stakeAprChangedCallback:      nil,
+		newPairCallback:                nil,
+		pairStateChangedCallback:       nil,
+		newStakeCallback:               nil,
+		newFarmCallback:                nil,
+		newDualFarmCallback:            nil,
+		newLaunchpadCallback:           nil,
+		launchpadEndedCallback:         nil,
+		launchpadStartedCallback:       nil,
+		hardCapReachedCallback:         nil,
+		annualReward1ChangedCallback:   nil,
+		annualReward2ChangedCallback:   nil,
+		stakeAprChangedCallback:        nil,
+		boostedStakeAprChangedCallback: nil,
 	}
 	one.startTasks()
 
@@ -156,6 +168,14 @@ func (one *OneDex) SetLaunchpadEndedCallback(f LaunchpadEndedCallbackFunc) {
 	one.launchpadEndedCallback = f
 }
 
+func (one *OneDex) SetLaunchpadStartedCallback(f LaunchpadStartedCallbackFunc) {
+	one.launchpadStartedCallback = f
+}
+
+func (one *OneDex) SetHardCapReachedCallback(f HardCapReachedCallbackFunc) {
+	one.hardCapReachedCallback = f
+}
+
 func (one *OneDex) SetAnnualReward1ChangedCallback(f AnnualRewardChangedCallbackFunc) {
 	one.annualReward1ChangedCallback = f
 }
@@ -168,6 +188,10 @@ func (one *OneDex) SetStakeAprChangedCallback(f StakeAprChangedCallbackFunc) {
 	one.stakeAprChangedCallback = f
 }
 
+func (one *OneDex) SetBoostedStakeAprChangedCallback(f BoostedStakeAprChangedCallbackFunc) {
+	one.boostedStakeAprChangedCallback = f
+}
+
 func (one *OneDex) GetLiquidityPools() (map[uint32]*LiquidityPool, error) {
 	keys, err := one.liquidityScAccount.GetAccountKeys("")
 	if err != nil {
@@ -270,6 +294,7 @@ func (one *OneDex) GetLiquidityPools() (map[uint32]*LiquidityPool, error) {
 
 			lp := lps[lpID]
 			iReserve := big.NewInt(0).SetBytes(value)
+			lp.RawToken1Reserve = iReserve
 			lp.Token1Reserve = utils.Denominate(iReserve, int(lp.Token1.Decimals))
 		}
 
@@ -289,6 +314,7 @@ func (one *OneDex) GetLiquidityPools() (map[uint32]*LiquidityPool, error) {
 
 			lp := lps[lpID]
 			iReserve := big.NewInt(0).SetBytes(value)
+			lp.RawToken2Reserve = iReserve
 			lp.Token2Reserve = utils.Denominate(iReserve, int(lp.Token2.Decimals))
 		}
 
@@ -308,6 +334,7 @@ func (one *OneDex) GetLiquidityPools() (map[uint32]*LiquidityPool, error) {
 
 			lp := lps[lpID]
 			iSupply := big.NewInt(0).SetBytes(value)
+			lp.RawLpTokenSupply = iSupply
 			lp.LpTokenSupply = utils.Denominate(iSupply, int(lp.LpToken.Decimals))
 		}
 
@@ -333,35 +360,41 @@ func (one *OneDex) GetLiquidityPools() (map[uint32]*LiquidityPool, error) {
 			}
 
 			lp.Enabled = enabled == 1
+		}
 
-			prefix = hex.EncodeToString([]byte("pair_state"))
-			if strings.HasPrefix(key, prefix) {
-				bytes, err := hex.DecodeString(strings.TrimPrefix(key, prefix))
-				if err != nil {
-					log.Debug("refreshLiquidityPools", "step", "parse keys", "error", "can not decode key", "key", key)
-					continue
-				}
-
-				lpID, _, ok := utils.ParseUint32(bytes, 0)
-				if !ok {
-					log.Debug("refreshLiquidityPools", "step", "parse keys", "error", "can not decode key", "key", key)
-					continue
-				}
+		prefix = hex.EncodeToString([]byte("pair_state"))
+		if strings.HasPrefix(key, prefix) {
+			bytes, err := hex.DecodeString(strings.TrimPrefix(key, prefix))
+			if err != nil {
+				log.Debug("refreshLiquidityPools", "step", "parse keys", "error", "can not decode key", "key", key)
+				continue
+			}
 
-				lp := lps[lpID]
-				state, _, ok := utils.ParseByte(value, 0)
-				if !ok {
-					log.Debug("refreshLiquidityPools", "step", "parse keys", "error", "can not decode key", "key", key)
-					continue
-				}
+			lpID, _, ok := utils.ParseUint32(bytes, 0)
+			if !ok {
+				log.Debug("refreshLiquidityPools", "step", "parse keys", "error", "can not decode key", "key", key)
+				continue
+			}
 
-				lp.State = state
+			lp := lps[lpID]
+			state, _, ok := utils.ParseByte(value, 0)
+			if !ok {
+				log.Debug("refreshLiquidityPools", "step", "parse keys", "error", "can not decode key", "key", key)
+				continue
 			}
+
+			lp.State = state
 		}
 	}
 
+	fee := defaultSwapFee
+	iFee, err := utils.GetBigIntKey("total_fee_percentage", keys)
+	if err == nil {
+		fee = float64(iFee.Uint64()) / 100
+	}
 	for _, lp := range lps {
 		lp.Token1Price = lp.Token2Reserve / lp.Token1Reserve
+		lp.Fee = fee
 	}
 
 	return lps, nil
@@ -1064,6 +1097,62 @@ func (one *OneDex) GetLaunchpads() (map[uint32]*Launchpad, error) {
 			launchpad.HardCap = utils.Denominate(iHardCap, int(token.Decimals))
 		}
 
+		prefix = hex.EncodeToString([]byte("project_min_buy_limit"))
+		if strings.HasPrefix(key, prefix) {
+			bytes, err := hex.DecodeString(strings.TrimPrefix(key, prefix))
+			if err != nil {
+				log.Debug("refreshLaunchpads", "step", "parse keys", "error", "can not decode key", "key", key)
+				continue
+			}
+
+			launchpadID, _, ok := utils.ParseUint32(bytes, 0)
+			if !ok {
+				log.Debug("refreshLaunchpads", "step", "parse keys", "error", "can not decode key", "key", key)
+				continue
+			}
+
+			launchpad := launchpads[launchpadID]
+			iMinBuy := big.NewInt(0).SetBytes(value)
+			tokenName := launchpad.FundToken
+			if tokenName == "EGLD" {
+				tokenName = utils.WEGLD
+			}
+			token, err := one.getToken(tokenName)
+			if err != nil {
+				log.Debug("refreshLaunchpads", "step", "parse keys", "error", "can not decode key", "key", key)
+				continue
+			}
+			launchpad.MinBuy = utils.Denominate(iMinBuy, int(token.Decimals))
+		}
+
+		prefix = hex.EncodeToString([]byte("project_max_buy_limit"))
+		if strings.HasPrefix(key, prefix) {
+			bytes, err := hex.DecodeString(strings.TrimPrefix(key, prefix))
+			if err != nil {
+				log.Debug("refreshLaunchpads", "step", "parse keys", "error", "can not decode key", "key", key)
+				continue
+			}
+
+			launchpadID, _, ok := utils.ParseUint32(bytes, 0)
+			if !ok {
+				log.Debug("refreshLaunchpads", "step", "parse keys", "error", "can not decode key", "key", key)
+				continue
+			}
+
+			launchpad := launchpads[launchpadID]
+			iMaxBuy := big.NewInt(0).SetBytes(value)
+			tokenName := launchpad.FundToken
+			if tokenName == "EGLD" {
+				tokenName = utils.WEGLD
+			}
+			token, err := one.getToken(tokenName)
+			if err != nil {
+				log.Debug("refreshLaunchpads", "step", "parse keys", "error", "can not decode key", "key", key)
+				continue
+			}
+			launchpad.MaxBuy = utils.Denominate(iMaxBuy, int(token.Decimals))
+		}
+
 		prefix = hex.EncodeToString([]byte("project_presale_token_rate"))
 		if strings.HasPrefix(key, prefix) {
 			bytes, err := hex.DecodeString(strings.TrimPrefix(key, prefix))
@@ -1174,6 +1263,12 @@ func (one *OneDex) GetLaunchpads() (map[uint32]*Launchpad, error) {
 		}
 	}
 
+	now := time.Now().Unix()
+	for _, launchpad := range launchpads {
+		launchpad.Started = launchpad.IsLive && now >= launchpad.StartTime && now < launchpad.EndTime
+		launchpad.HardCapReached = launchpad.HardCap > 0 && launchpad.FundAmount >= launchpad.HardCap
+	}
+
 	return launchpads, nil
 }
 
@@ -1252,18 +1347,13 @@ func (one *OneDex) GetCachedUserFarms(address string) []*UserFarm {
 	for _, farm := range one.farms {
 		for _, farmer := range farm.Farmers {
 			if farmer.Address == address {
-				days := float64(time.Now().Unix()-farmer.LastUpdate) / 86400
-				reward1 := farmer.Amount * farm.AnnualRewardPerLP1 / 365 * days
-				userFarm := &UserFarm{
+				reward1, reward2 := estimateFarmRewards(farm, farmer)
+				userFarms = append(userFarms, &UserFarm{
 					Farm:    farm,
 					Amount:  farmer.Amount,
 					Reward1: reward1,
-				}
-				userFarms = append(userFarms, userFarm)
-				if farm.RewardToken2 != nil {
-					reward2 := farmer.Amount * farm.AnnualRewardPerLP2 / 365 * days
-					userFarm.Reward2 = reward2
-				}
+					Reward2: reward2,
+				})
 			}
 		}
 	}
@@ -1279,11 +1369,11 @@ func (one *OneDex) GetCachedUserStakes(address string) []*UserStake {
 	for _, stake := range one.stakes {
 		for _, staker := range stake.Stakers {
 			if staker.Address == address {
-				days := float64(time.Now().Unix()-staker.LastUpdate) / 86400
 				userStakes = append(userStakes, &UserStake{
+					Stake:  stake,
 					Token:  stake.Token,
 					Amount: staker.Amount,
-					Reward: staker.Amount * stake.APR / 100 * days / 365,
+					Reward: estimateStakeReward(stake, staker),
 				})
 			}
 		}
@@ -1293,6 +1383,7 @@ func (one *OneDex) GetCachedUserStakes(address string) []*UserStake {
 	return userStakes
 }
 
+// Deprecated: stablecoins are considered exactly 1 and only WEGLD and ONE pairs are used, use the pricing package
 func (one *OneDex) GetCachedTokenPrice(ticker string, egldPrice float64) float64 {
 	if ticker == utils.USDC || ticker == utils.BUSD || ticker == utils.USDT {
 		return 1
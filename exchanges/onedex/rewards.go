@@ -0,0 +1,246 @@
+package onedex
+
+import (
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+type RewardReconciliation struct {
+	PoolID            uint32
+	Token             string
+	Estimated         float64
+	Actual            float64
+	Difference        float64
+	DifferencePercent float64
+}
+
+// linear estimation since the last update, it does not account for depleted reward pools or rate changes
+func estimateFarmRewards(farm *Farm, farmer *Farmer) (float64, float64) {
+	days := float64(time.Now().Unix()-farmer.LastUpdate) / 86400
+	reward1 := farmer.Amount * farm.AnnualRewardPerLP1 / 365 * days
+	reward2 := float64(0)
+	if farm.IsDual() {
+		reward2 = farmer.Amount * farm.AnnualRewardPerLP2 / 365 * days
+	}
+
+	return reward1, reward2
+}
+
+func estimateStakeReward(stake *Stake, staker *Staker) float64 {
+	days := float64(time.Now().Unix()-staker.LastUpdate) / 86400
+
+	return staker.Amount * stake.APR / 100 * days / 365
+}
+
+func (one *OneDex) GetUserFarmRewards(address string, farm *Farm) (float64, float64, error) {
+	if farm.RewardToken1 == nil {
+		return 0, 0, utils.ErrInvalidResponse
+	}
+
+	res, err := one.queryUserRewards(farmSC, farm.ID, address)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if len(res) == 0 {
+		return 0, 0, nil
+	}
+
+	// the farm returns both rewards as a tuple, the second one being 0 for simple farms
+	reward1, idx, ok := utils.ParseBigInt(res, 0)
+	allOk := ok
+	reward2, _, ok := utils.ParseBigInt(res, idx)
+	allOk = allOk && ok
+	if !allOk {
+		return 0, 0, utils.ErrInvalidResponse
+	}
+
+	res1 := utils.Denominate(reward1, int(farm.RewardToken1.Decimals))
+	res2 := float64(0)
+	if farm.IsDual() {
+		res2 = utils.Denominate(reward2, int(farm.RewardToken2.Decimals))
+	}
+
+	return res1, res2, nil
+}
+
+func (one *OneDex) GetUserStakeReward(address string, stake *Stake) (float64, error) {
+	if stake.Token == nil {
+		return 0, utils.ErrInvalidResponse
+	}
+
+	res, err := one.queryUserRewards(stakingSC, stake.ID, address)
+	if err != nil {
+		return 0, err
+	}
+
+	return utils.Denominate(big.NewInt(0).SetBytes(res), int(stake.Token.Decimals)), nil
+}
+
+func (one *OneDex) GetUserFarms(address string) ([]*UserFarm, error) {
+	farms, err := one.getFarms()
+	if err != nil {
+		return nil, err
+	}
+
+	userFarms := make([]*UserFarm, 0)
+	for _, farm := range farms {
+		for _, farmer := range farm.Farmers {
+			if farmer.Address != address {
+				continue
+			}
+
+			reward1, reward2, err := one.GetUserFarmRewards(address, farm)
+			if err != nil {
+				return nil, err
+			}
+
+			userFarms = append(userFarms, &UserFarm{
+				Farm:    farm,
+				Amount:  farmer.Amount,
+				Reward1: reward1,
+				Reward2: reward2,
+			})
+		}
+	}
+
+	return userFarms, nil
+}
+
+func (one *OneDex) GetUserStakes(address string) ([]*UserStake, error) {
+	stakes, err := one.getStakes()
+	if err != nil {
+		return nil, err
+	}
+
+	userStakes := make([]*UserStake, 0)
+	for _, stake := range stakes {
+		for _, staker := range stake.Stakers {
+			if staker.Address != address {
+				continue
+			}
+
+			reward, err := one.GetUserStakeReward(address, stake)
+			if err != nil {
+				return nil, err
+			}
+
+			userStakes = append(userStakes, &UserStake{
+				Stake:  stake,
+				Token:  stake.Token,
+				Amount: staker.Amount,
+				Reward: reward,
+			})
+		}
+	}
+
+	return userStakes, nil
+}
+
+// compares the linear estimation used by GetCachedUserFarms with the rewards computed by the contract
+func (one *OneDex) ReconcileUserFarms(address string) ([]*RewardReconciliation, error) {
+	farms, err := one.getFarms()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*RewardReconciliation, 0)
+	for _, farm := range farms {
+		for _, farmer := range farm.Farmers {
+			if farmer.Address != address {
+				continue
+			}
+
+			estimated1, estimated2 := estimateFarmRewards(farm, farmer)
+			actual1, actual2, err := one.GetUserFarmRewards(address, farm)
+			if err != nil {
+				return nil, err
+			}
+
+			res = append(res, newRewardReconciliation(farm.ID, farm.RewardToken1.Ticker, estimated1, actual1))
+			if farm.IsDual() {
+				res = append(res, newRewardReconciliation(farm.ID, farm.RewardToken2.Ticker, estimated2, actual2))
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func (one *OneDex) ReconcileUserStakes(address string) ([]*RewardReconciliation, error) {
+	stakes, err := one.getStakes()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*RewardReconciliation, 0)
+	for _, stake := range stakes {
+		for _, staker := range stake.Stakers {
+			if staker.Address != address {
+				continue
+			}
+
+			actual, err := one.GetUserStakeReward(address, stake)
+			if err != nil {
+				return nil, err
+			}
+
+			res = append(res, newRewardReconciliation(stake.ID, stake.Token.Ticker, estimateStakeReward(stake, staker), actual))
+		}
+	}
+
+	return res, nil
+}
+
+func newRewardReconciliation(poolID uint32, token string, estimated float64, actual float64) *RewardReconciliation {
+	res := &RewardReconciliation{
+		PoolID:     poolID,
+		Token:      token,
+		Estimated:  estimated,
+		Actual:     actual,
+		Difference: estimated - actual,
+	}
+	if actual != 0 {
+		res.DifferencePercent = res.Difference * 100 / math.Abs(actual)
+	}
+
+	return res
+}
+
+func (one *OneDex) queryUserRewards(contractAddress string, poolID uint32, address string) ([]byte, error) {
+	sAddress, err := utils.AddressArg(address)
+	if err != nil {
+		return nil, err
+	}
+
+	args := []string{utils.Uint64Arg(uint64(poolID)), sAddress}
+	res, err := one.netMan.QuerySC(contractAddress, "getPoolUserCurrentRewardAmount", args)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res.Data.ReturnData) == 0 {
+		return nil, nil
+	}
+
+	return res.Data.ReturnData[0], nil
+}
+
+func (one *OneDex) getFarms() (map[uint32]*Farm, error) {
+	if one.refreshInterval == utils.NoRefresh {
+		return one.GetFarms()
+	}
+
+	return one.GetCachedFarms()
+}
+
+func (one *OneDex) getStakes() (map[uint32]*Stake, error) {
+	if one.refreshInterval == utils.NoRefresh {
+		return one.GetStakes()
+	}
+
+	return one.GetCachedStakes()
+}
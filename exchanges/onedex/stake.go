@@ -19,13 +19,20 @@ type Staker struct {
 }
 
 type UserStake struct {
+	Stake  *Stake
 	Token  *data.ESDT
 	Amount float64
 	Reward float64
 }
 
 type BoostedStake struct {
-	TotalStake float64
-	APR        float64
-	Stakers    []*Staker
+	ContractAddress string
+	SftID           string
+	SftNonce        uint64
+	RewardToken     *data.ESDT
+	MinStake        float64
+	TotalStake      float64
+	RewardPool      float64
+	APR             float64
+	Stakers         []*Staker
 }
@@ -0,0 +1,147 @@
+package onedex
+
+import (
+	"github.com/stakingagency/sa-mx-sdk-go/network"
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+const stakeGasLimit = uint64(20000000)
+
+type StakeResult struct {
+	TxHash  string
+	StakeID uint32
+	Amount  float64
+	Reward  float64
+}
+
+func (one *OneDex) Stake(pk []byte, stake *Stake, amount float64) (*StakeResult, error) {
+	if stake.Token == nil {
+		return nil, utils.ErrInvalidResponse
+	}
+
+	iAmount := utils.Renominate(amount, int(stake.Token.Decimals))
+	if iAmount.Sign() <= 0 {
+		return nil, utils.ErrInvalidAmount
+	}
+
+	args := []string{utils.Uint64Arg(uint64(stake.ID))}
+	hash, err := one.netMan.SendEsdtTransaction(pk, stakingSC, amount, stakeGasLimit, stake.Token, "userStake", args, utils.AutoNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &StakeResult{
+		TxHash:  hash,
+		StakeID: stake.ID,
+		Amount:  amount,
+	}
+	err = one.getStakeRewardsReceived(pk, stake, result)
+
+	return result, err
+}
+
+func (one *OneDex) Unstake(pk []byte, stake *Stake, amount float64) (*StakeResult, error) {
+	if stake.Token == nil {
+		return nil, utils.ErrInvalidResponse
+	}
+
+	iAmount := utils.Renominate(amount, int(stake.Token.Decimals))
+	if iAmount.Sign() <= 0 {
+		return nil, utils.ErrInvalidAmount
+	}
+
+	staker, err := getStaker(pk, stake)
+	if err != nil {
+		return nil, err
+	}
+
+	if staker == nil || staker.Amount < amount {
+		return nil, utils.ErrInsufficientStake
+	}
+
+	dataField := "userUnstake@" + utils.Uint64Arg(uint64(stake.ID)) + "@" + utils.BigIntArg(iAmount)
+	hash, err := one.netMan.SendTransaction(pk, stakingSC, 0, stakeGasLimit, dataField, utils.AutoNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &StakeResult{
+		TxHash:  hash,
+		StakeID: stake.ID,
+		Amount:  amount,
+	}
+	err = one.getStakeRewardsReceived(pk, stake, result)
+	if err != nil {
+		return result, err
+	}
+
+	// the unstaked tokens are received along with the rewards
+	result.Reward -= amount
+	if result.Reward < 0 {
+		result.Reward = 0
+	}
+
+	return result, nil
+}
+
+func (one *OneDex) ClaimStakeRewards(pk []byte, stake *Stake) (*StakeResult, error) {
+	staker, err := getStaker(pk, stake)
+	if err != nil {
+		return nil, err
+	}
+
+	if staker == nil || (staker.Amount == 0 && staker.Reward == 0) || stake.RewardPool == 0 {
+		return nil, utils.ErrNothingToClaim
+	}
+
+	reward, err := one.GetUserStakeReward(staker.Address, stake)
+	if err != nil {
+		return nil, err
+	}
+
+	if reward == 0 {
+		return nil, utils.ErrNothingToClaim
+	}
+
+	hash, err := one.netMan.SendTransaction(pk, stakingSC, 0, stakeGasLimit, "userClaim@"+utils.Uint64Arg(uint64(stake.ID)), utils.AutoNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &StakeResult{
+		TxHash:  hash,
+		StakeID: stake.ID,
+	}
+	err = one.getStakeRewardsReceived(pk, stake, result)
+
+	return result, err
+}
+
+// the rewards are paid in the staked token
+func (one *OneDex) getStakeRewardsReceived(pk []byte, stake *Stake, result *StakeResult) error {
+	received, err := one.getReceivedAmounts(pk, result.TxHash)
+	if err != nil {
+		return err
+	}
+
+	if received[stake.Token.Ticker] != nil {
+		result.Reward = utils.Denominate(received[stake.Token.Ticker], int(stake.Token.Decimals))
+	}
+
+	return nil
+}
+
+func getStaker(pk []byte, stake *Stake) (*Staker, error) {
+	address, err := network.GetAddressFromPrivateKey(pk)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, staker := range stake.Stakers {
+		if staker.Address == address {
+			return staker, nil
+		}
+	}
+
+	return nil, nil
+}
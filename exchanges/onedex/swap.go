@@ -0,0 +1,205 @@
+package onedex
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/stakingagency/sa-mx-sdk-go/data"
+	"github.com/stakingagency/sa-mx-sdk-go/network"
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+const (
+	defaultSwapFee = float64(1)
+	swapGasLimit   = uint64(20000000)
+	maxSlippage    = float64(100)
+)
+
+func (one *OneDex) SwapMultiTokensFixedInput(pk []byte, path []string, amountIn *big.Int, minAmountOut *big.Int) (string, error) {
+	if len(path) < 2 {
+		return "", utils.ErrInvalidRoute
+	}
+
+	dataField := fmt.Sprintf("ESDTTransfer@%s@%s@%s@%s@%s", utils.StringArg(path[0]), utils.BigIntArg(amountIn),
+		utils.StringArg("swapMultiTokensFixedInput"), utils.BigIntArg(minAmountOut), utils.BoolArg(false))
+	for _, ticker := range path {
+		dataField += "@" + utils.StringArg(ticker)
+	}
+	gasLimit := swapGasLimit * uint64(len(path)-1)
+	hash, err := one.netMan.SendTransaction(pk, liquidityPoolSC, 0, gasLimit, dataField, utils.AutoNonce)
+	if err != nil {
+		return "", err
+	}
+
+	err = one.netMan.GetTxResult(hash)
+	if err != nil {
+		return hash, err
+	}
+
+	return hash, nil
+}
+
+func (one *OneDex) QuoteFixedInput(lp *LiquidityPool, tokenIn string, amountIn float64) (float64, error) {
+	token, err := lp.GetToken(tokenIn)
+	if err != nil {
+		return 0, err
+	}
+
+	otherToken, _ := lp.GetOtherToken(tokenIn)
+	amountOut, err := lp.GetAmountOut(tokenIn, utils.Renominate(amountIn, int(token.Decimals)))
+	if err != nil {
+		return 0, err
+	}
+
+	return utils.Denominate(amountOut, int(otherToken.Decimals)), nil
+}
+
+func (one *OneDex) QuoteFixedOutput(lp *LiquidityPool, tokenOut string, amountOut float64) (float64, error) {
+	token, err := lp.GetToken(tokenOut)
+	if err != nil {
+		return 0, err
+	}
+
+	otherToken, _ := lp.GetOtherToken(tokenOut)
+	amountIn, err := lp.GetAmountIn(tokenOut, utils.Renominate(amountOut, int(token.Decimals)))
+	if err != nil {
+		return 0, err
+	}
+
+	return utils.Denominate(amountIn, int(otherToken.Decimals)), nil
+}
+
+func (one *OneDex) SwapFixedInput(pk []byte, lp *LiquidityPool, tokenIn string, amountIn float64, slippage float64) (*data.SwapResult, error) {
+	if !lp.CanSwap() {
+		return nil, utils.ErrPairNotActive
+	}
+
+	if slippage < 0 || slippage >= maxSlippage {
+		return nil, utils.ErrInvalidSlippage
+	}
+
+	token, err := lp.GetToken(tokenIn)
+	if err != nil {
+		return nil, err
+	}
+
+	otherToken, _ := lp.GetOtherToken(tokenIn)
+	iAmountIn := utils.Renominate(amountIn, int(token.Decimals))
+	if iAmountIn.Sign() <= 0 {
+		return nil, utils.ErrInvalidAmount
+	}
+
+	expectedOut, err := lp.GetAmountOut(tokenIn, iAmountIn)
+	if err != nil {
+		return nil, err
+	}
+
+	minOut := utils.ApplySlippage(expectedOut, slippage, false)
+	if minOut.Sign() == 0 {
+		return nil, utils.ErrInsufficientLiquidity
+	}
+
+	args := []string{utils.BigIntArg(minOut), utils.BoolArg(false), utils.StringArg(token.Ticker), utils.StringArg(otherToken.Ticker)}
+	result := &data.SwapResult{
+		Pair:              liquidityPoolSC,
+		TokenIn:           token.Ticker,
+		TokenOut:          otherToken.Ticker,
+		AmountIn:          amountIn,
+		ExpectedAmountOut: utils.Denominate(expectedOut, int(otherToken.Decimals)),
+		MinAmountOut:      utils.Denominate(minOut, int(otherToken.Decimals)),
+	}
+	err = one.sendSwap(pk, token, iAmountIn, "swapMultiTokensFixedInput", args, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (one *OneDex) SwapFixedOutput(pk []byte, lp *LiquidityPool, tokenOut string, amountOut float64, slippage float64) (*data.SwapResult, error) {
+	if !lp.CanSwap() {
+		return nil, utils.ErrPairNotActive
+	}
+
+	if slippage < 0 || slippage >= maxSlippage {
+		return nil, utils.ErrInvalidSlippage
+	}
+
+	token, err := lp.GetToken(tokenOut)
+	if err != nil {
+		return nil, err
+	}
+
+	otherToken, _ := lp.GetOtherToken(tokenOut)
+	iAmountOut := utils.Renominate(amountOut, int(token.Decimals))
+	if iAmountOut.Sign() <= 0 {
+		return nil, utils.ErrInvalidAmount
+	}
+
+	expectedIn, err := lp.GetAmountIn(tokenOut, iAmountOut)
+	if err != nil {
+		return nil, err
+	}
+
+	maxIn := utils.ApplySlippage(expectedIn, slippage, true)
+	args := []string{utils.BigIntArg(iAmountOut), utils.BoolArg(false), utils.StringArg(otherToken.Ticker), utils.StringArg(token.Ticker)}
+	result := &data.SwapResult{
+		Pair:              liquidityPoolSC,
+		TokenIn:           otherToken.Ticker,
+		TokenOut:          token.Ticker,
+		AmountIn:          utils.Denominate(maxIn, int(otherToken.Decimals)),
+		ExpectedAmountOut: amountOut,
+		MinAmountOut:      amountOut,
+	}
+	err = one.sendSwap(pk, otherToken, maxIn, "swapMultiTokensFixedOutput", args, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (one *OneDex) sendSwap(pk []byte, tokenIn *data.ESDT, amountIn *big.Int, function string, args []string, result *data.SwapResult) error {
+	sender, err := network.GetAddressFromPrivateKey(pk)
+	if err != nil {
+		return err
+	}
+
+	dataField := fmt.Sprintf("ESDTTransfer@%s@%s@%s", utils.StringArg(tokenIn.Ticker), utils.BigIntArg(amountIn), utils.StringArg(function))
+	for _, arg := range args {
+		dataField += "@" + arg
+	}
+	hash, err := one.netMan.SendTransaction(pk, liquidityPoolSC, 0, swapGasLimit, dataField, utils.AutoNonce)
+	if err != nil {
+		return err
+	}
+
+	result.TxHash = hash
+	err = one.netMan.GetTxResult(hash)
+	if err != nil {
+		return err
+	}
+
+	received, err := one.netMan.GetTxReceivedAmounts(hash, sender)
+	if err != nil {
+		return err
+	}
+
+	tokenOut, err := one.getToken(result.TokenOut)
+	if err != nil {
+		return err
+	}
+
+	iAmountOut := received[result.TokenOut]
+	if iAmountOut == nil {
+		return utils.ErrEventNotFound
+	}
+
+	result.AmountOut = utils.Denominate(iAmountOut, int(tokenOut.Decimals))
+	refund := received[tokenIn.Ticker]
+	if refund != nil {
+		result.AmountIn -= utils.Denominate(refund, int(tokenIn.Decimals))
+	}
+
+	return nil
+}
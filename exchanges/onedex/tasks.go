@@ -21,6 +21,7 @@ func (one *OneDex) startTasks() {
 			one.refreshFarms()
 			one.refreshStakes()
 			one.refreshLaunchpads()
+			one.refreshBoostedStake()
 
 			endTime := time.Now().UnixNano()
 			waitTime := one.refreshInterval - time.Duration(endTime-startTime)
@@ -145,6 +146,12 @@ func (one *OneDex) refreshLaunchpads() {
 				if !newLaunchpad.IsLive && oldLaunchpad.IsLive && one.launchpadEndedCallback != nil {
 					one.launchpadEndedCallback(newLaunchpad.Token)
 				}
+				if newLaunchpad.Started && !oldLaunchpad.Started && one.launchpadStartedCallback != nil {
+					one.launchpadStartedCallback(newLaunchpad.Token)
+				}
+				if newLaunchpad.HardCapReached && !oldLaunchpad.HardCapReached && one.hardCapReachedCallback != nil {
+					one.hardCapReachedCallback(newLaunchpad.Token)
+				}
 			}
 			one.launchpadsMut.Lock()
 		}
@@ -152,3 +159,24 @@ func (one *OneDex) refreshLaunchpads() {
 	one.launchpads = newLaunchpads
 	one.launchpadsMut.Unlock()
 }
+
+func (one *OneDex) refreshBoostedStake() {
+	if one.boostedStakingSC == "" {
+		return
+	}
+
+	newStake, err := one.GetBoostedStake()
+	if err != nil {
+		log.Error("get boosted stake", "error", err, "function", "refreshBoostedStake")
+		return
+	}
+
+	one.boostedStakeMut.Lock()
+	oldStake := one.boostedStake
+	one.boostedStake = newStake
+	one.boostedStakeMut.Unlock()
+
+	if oldStake != nil && oldStake.APR != newStake.APR && one.boostedStakeAprChangedCallback != nil {
+		one.boostedStakeAprChangedCallback(oldStake.APR, newStake.APR)
+	}
+}
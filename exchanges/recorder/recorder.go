@@ -0,0 +1,277 @@
+package recorder
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/stakingagency/sa-mx-sdk-go/data"
+	"github.com/stakingagency/sa-mx-sdk-go/exchanges/onedex"
+	"github.com/stakingagency/sa-mx-sdk-go/exchanges/xexchange"
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+const (
+	Interval1m  = time.Minute
+	Interval5m  = 5 * time.Minute
+	Interval15m = 15 * time.Minute
+	Interval1h  = time.Hour
+	Interval4h  = 4 * time.Hour
+	Interval1d  = 24 * time.Hour
+
+	defaultRetention          = 7 * 24 * time.Hour
+	defaultPriceMoveThreshold = float64(5)
+)
+
+type (
+	PriceMoveCallbackFunc func(pair *data.RecordedPair, oldPrice float64, newPrice float64, change float64)
+)
+
+type pairHistory struct {
+	pair           *data.RecordedPair
+	samples        []*data.PriceSample
+	referencePrice float64
+}
+
+type Recorder struct {
+	xex             *xexchange.XExchange
+	one             *onedex.OneDex
+	refreshInterval time.Duration
+
+	retention          time.Duration
+	priceMoveThreshold float64
+
+	history    map[string]*pairHistory
+	historyMut sync.Mutex
+
+	priceMoveCallback PriceMoveCallbackFunc
+}
+
+var log = logger.GetOrCreate("recorder")
+
+func NewRecorder(xex *xexchange.XExchange, one *onedex.OneDex, refreshInterval time.Duration) (*Recorder, error) {
+	if refreshInterval == utils.NoRefresh {
+		return nil, utils.ErrRefreshIntervalNotSet
+	}
+
+	rec := &Recorder{
+		xex:             xex,
+		one:             one,
+		refreshInterval: refreshInterval,
+
+		retention:          defaultRetention,
+		priceMoveThreshold: defaultPriceMoveThreshold,
+
+		history: make(map[string]*pairHistory),
+
+		priceMoveCallback: nil,
+	}
+	rec.startTasks()
+
+	return rec, nil
+}
+
+func (rec *Recorder) SetPriceMoveCallback(f PriceMoveCallbackFunc) {
+	rec.priceMoveCallback = f
+}
+
+func (rec *Recorder) SetPriceMoveThreshold(percent float64) {
+	rec.priceMoveThreshold = percent
+}
+
+func (rec *Recorder) SetRetention(retention time.Duration) {
+	rec.retention = retention
+}
+
+func GetPairKey(dex string, pool string) string {
+	return fmt.Sprintf("%s:%s", dex, pool)
+}
+
+func (rec *Recorder) GetPairs() []*data.RecordedPair {
+	rec.historyMut.Lock()
+	res := make([]*data.RecordedPair, 0, len(rec.history))
+	for _, h := range rec.history {
+		res = append(res, h.pair)
+	}
+	rec.historyMut.Unlock()
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Key < res[j].Key
+	})
+
+	return res
+}
+
+func (rec *Recorder) FindPairs(token1 string, token2 string) []*data.RecordedPair {
+	res := make([]*data.RecordedPair, 0)
+	for _, pair := range rec.GetPairs() {
+		if (pair.Token1 == token1 && pair.Token2 == token2) || (pair.Token1 == token2 && pair.Token2 == token1) {
+			res = append(res, pair)
+		}
+	}
+
+	return res
+}
+
+func (rec *Recorder) GetSamples(pairKey string, from time.Time, to time.Time) ([]*data.PriceSample, error) {
+	rec.historyMut.Lock()
+	defer rec.historyMut.Unlock()
+
+	h := rec.history[pairKey]
+	if h == nil {
+		return nil, utils.ErrPairNotFound
+	}
+
+	start := sort.Search(len(h.samples), func(i int) bool {
+		return h.samples[i].Timestamp >= from.Unix()
+	})
+	res := make([]*data.PriceSample, 0)
+	for _, sample := range h.samples[start:] {
+		if sample.Timestamp > to.Unix() {
+			break
+		}
+
+		res = append(res, sample)
+	}
+
+	return res, nil
+}
+
+func (rec *Recorder) GetCandles(pairKey string, interval time.Duration, from time.Time, to time.Time) ([]*data.Candle, error) {
+	if interval < time.Second {
+		return nil, utils.ErrInvalidInterval
+	}
+
+	samples, err := rec.GetSamples(pairKey, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return BuildCandles(samples, interval), nil
+}
+
+func BuildCandles(samples []*data.PriceSample, interval time.Duration) []*data.Candle {
+	seconds := int64(interval.Seconds())
+	candles := make([]*data.Candle, 0)
+	var candle *data.Candle
+	for _, sample := range samples {
+		start := sample.Timestamp - sample.Timestamp%seconds
+		if candle == nil || candle.Start != start {
+			candle = &data.Candle{
+				Start: start,
+				Open:  sample.Price,
+				High:  sample.Price,
+				Low:   sample.Price,
+			}
+			candles = append(candles, candle)
+		}
+		candle.High = math.Max(candle.High, sample.Price)
+		candle.Low = math.Min(candle.Low, sample.Price)
+		candle.Close = sample.Price
+		candle.Volume += sample.Volume
+	}
+
+	return candles
+}
+
+func (rec *Recorder) getLastSample(pairKey string) *data.PriceSample {
+	rec.historyMut.Lock()
+	defer rec.historyMut.Unlock()
+
+	h := rec.history[pairKey]
+	if h == nil || len(h.samples) == 0 {
+		return nil
+	}
+
+	return h.samples[len(h.samples)-1]
+}
+
+// the volume since the last sample, in token1, is summed from the indexed swap events. Pairs whose reserves didn't
+// change had no swaps, so the indexer is only queried for the others
+func (rec *Recorder) getVolume(pair *data.RecordedPair, timestamp int64, reserve1 float64, reserve2 float64, decimals1 int,
+	getSwapEvents func(from time.Time, to time.Time) ([]*data.SwapEvent, error)) float64 {
+	last := rec.getLastSample(pair.Key)
+	if last == nil || (last.Reserve1 == reserve1 && last.Reserve2 == reserve2) {
+		return 0
+	}
+
+	events, err := getSwapEvents(time.Unix(last.Timestamp+1, 0), time.Unix(timestamp, 0))
+	if err != nil {
+		log.Error("get swap events", "error", err, "pair", pair.Key, "function", "getVolume")
+		return 0
+	}
+
+	return getSwapsVolume(events, pair.Token1, decimals1)
+}
+
+func getSwapsVolume(events []*data.SwapEvent, token1 string, decimals1 int) float64 {
+	volume := big.NewInt(0)
+	for _, event := range events {
+		if event.TokenIn == token1 && event.AmountIn != nil {
+			volume.Add(volume, event.AmountIn)
+		} else if event.TokenOut == token1 && event.AmountOut != nil {
+			volume.Add(volume, event.AmountOut)
+		}
+	}
+
+	return utils.Denominate(volume, decimals1)
+}
+
+func (rec *Recorder) record(pair *data.RecordedPair, timestamp int64, reserve1 float64, reserve2 float64, volume float64) {
+	if reserve1 == 0 {
+		return
+	}
+
+	sample := &data.PriceSample{
+		Timestamp: timestamp,
+		Reserve1:  reserve1,
+		Reserve2:  reserve2,
+		Price:     reserve2 / reserve1,
+		Volume:    volume,
+	}
+
+	rec.historyMut.Lock()
+	h := rec.history[pair.Key]
+	if h == nil {
+		h = &pairHistory{
+			pair:           pair,
+			samples:        make([]*data.PriceSample, 0),
+			referencePrice: sample.Price,
+		}
+		rec.history[pair.Key] = h
+	}
+	h.samples = append(h.samples, sample)
+	cutoff := timestamp - int64(rec.retention.Seconds())
+	idx := sort.Search(len(h.samples), func(i int) bool {
+		return h.samples[i].Timestamp >= cutoff
+	})
+	h.samples = h.samples[idx:]
+
+	oldPrice := h.referencePrice
+	change := float64(0)
+	if oldPrice != 0 {
+		change = (sample.Price - oldPrice) / oldPrice * 100
+	}
+	moved := math.Abs(change) >= rec.priceMoveThreshold
+	if moved {
+		h.referencePrice = sample.Price
+	}
+	rec.historyMut.Unlock()
+
+	if moved && rec.priceMoveCallback != nil {
+		rec.priceMoveCallback(pair, oldPrice, sample.Price, change)
+	}
+}
+
+func newRecordedPair(dex string, pool string, token1 string, token2 string) *data.RecordedPair {
+	return &data.RecordedPair{
+		Key:    GetPairKey(dex, pool),
+		Dex:    dex,
+		Pool:   pool,
+		Token1: token1,
+		Token2: token2,
+	}
+}
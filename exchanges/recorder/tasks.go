@@ -0,0 +1,42 @@
+package recorder
+
+import (
+	"time"
+
+	"github.com/stakingagency/sa-mx-sdk-go/data"
+	"github.com/stakingagency/sa-mx-sdk-go/exchanges/router"
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+func (rec *Recorder) startTasks() {
+	go func() {
+		for {
+			startTime := time.Now().UnixNano()
+
+			rec.recordPairs()
+
+			endTime := time.Now().UnixNano()
+			waitTime := rec.refreshInterval - time.Duration(endTime-startTime)
+			if waitTime > 0 {
+				time.Sleep(waitTime)
+			}
+		}
+	}()
+}
+
+func (rec *Recorder) recordPairs() {
+	timestamp := time.Now().Unix()
+	for _, p := range router.GetPools(rec.xex, rec.one) {
+		recordedPair := newRecordedPair(p.Dex, p.ID, p.Token1.Ticker, p.Token2.Ticker)
+		reserve1 := utils.Denominate(p.Reserve1, int(p.Token1.Decimals))
+		reserve2 := utils.Denominate(p.Reserve2, int(p.Token2.Decimals))
+		volume := rec.getVolume(recordedPair, timestamp, reserve1, reserve2, int(p.Token1.Decimals), func(from time.Time, to time.Time) ([]*data.SwapEvent, error) {
+			if p.Pair != nil {
+				return rec.xex.GetSwapEvents(p.Pair, from, to)
+			}
+
+			return rec.one.GetSwapEvents(p.LiquidityPool, from, to)
+		})
+		rec.record(recordedPair, timestamp, reserve1, reserve2, volume)
+	}
+}
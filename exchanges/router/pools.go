@@ -0,0 +1,132 @@
+package router
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/stakingagency/sa-mx-sdk-go/data"
+	"github.com/stakingagency/sa-mx-sdk-go/exchanges/analytics"
+	"github.com/stakingagency/sa-mx-sdk-go/exchanges/onedex"
+	"github.com/stakingagency/sa-mx-sdk-go/exchanges/xexchange"
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+// Pool is a common view of an xExchange pair or a OneDex liquidity pool
+type Pool struct {
+	Dex      string
+	ID       string
+	Active   bool
+	Token1   *data.ESDT
+	Token2   *data.ESDT
+	Reserve1 *big.Int
+	Reserve2 *big.Int
+	Fee      *big.Int
+	LpToken  string
+	LpSupply float64
+
+	Pair          *xexchange.DexPair
+	LiquidityPool *onedex.LiquidityPool
+}
+
+// lists the cached pools of both DEXes which have both tokens and reserves loaded, including the inactive ones
+func GetPools(xex *xexchange.XExchange, one *onedex.OneDex) []*Pool {
+	pools := make([]*Pool, 0)
+	if xex != nil {
+		pairs, err := xex.GetCachedDexPairs()
+		if err != nil {
+			log.Warn("get cached dex pairs", "error", err, "function", "GetPools")
+		}
+		for _, pair := range pairs {
+			if pair.Token1 == nil || pair.Token2 == nil || pair.Balance1 == nil || pair.Balance2 == nil {
+				continue
+			}
+
+			p := &Pool{
+				Dex:      utils.DexXExchange,
+				ID:       pair.ContractAddress,
+				Active:   pair.State,
+				Token1:   pair.Token1,
+				Token2:   pair.Token2,
+				Reserve1: pair.Balance1,
+				Reserve2: pair.Balance2,
+				Fee:      pair.GetFee(),
+				LpToken:  pair.LpToken,
+				Pair:     pair,
+			}
+			if pair.LpSupply != nil {
+				p.LpSupply = utils.Denominate(pair.LpSupply, xexchange.LpTokenDecimals)
+			}
+			pools = append(pools, p)
+		}
+	}
+	if one != nil {
+		lps, err := one.GetCachedLiquidityPools()
+		if err != nil {
+			log.Warn("get cached liquidity pools", "error", err, "function", "GetPools")
+		}
+		for id, lp := range lps {
+			if lp.Token1 == nil || lp.Token2 == nil || lp.RawToken1Reserve == nil || lp.RawToken2Reserve == nil {
+				continue
+			}
+
+			p := &Pool{
+				Dex:           utils.DexOneDex,
+				ID:            fmt.Sprintf("%v", id),
+				Active:        lp.Enabled,
+				Token1:        lp.Token1,
+				Token2:        lp.Token2,
+				Reserve1:      lp.RawToken1Reserve,
+				Reserve2:      lp.RawToken2Reserve,
+				Fee:           lp.GetFee(),
+				LpSupply:      lp.LpTokenSupply,
+				LiquidityPool: lp,
+			}
+			if lp.LpToken != nil {
+				p.LpToken = lp.LpToken.Ticker
+			}
+			pools = append(pools, p)
+		}
+	}
+
+	return pools
+}
+
+// same as GetPools, without the inactive pools
+func GetActivePools(xex *xexchange.XExchange, one *onedex.OneDex) []*Pool {
+	pools := make([]*Pool, 0)
+	for _, p := range GetPools(xex, one) {
+		if p.Active {
+			pools = append(pools, p)
+		}
+	}
+
+	return pools
+}
+
+func (p *Pool) GetKey() string {
+	return p.Dex + ":" + p.ID
+}
+
+func (p *Pool) GetReserves(tokenIn string) (*big.Int, *big.Int) {
+	if tokenIn == p.Token1.Ticker {
+		return p.Reserve1, p.Reserve2
+	}
+
+	return p.Reserve2, p.Reserve1
+}
+
+// returns 0 if the swap can not be done
+func (p *Pool) GetAmountOut(tokenIn string, amountIn *big.Int) *big.Int {
+	reserveIn, reserveOut := p.GetReserves(tokenIn)
+	amountOut, err := analytics.GetAmountOut(reserveIn, reserveOut, amountIn, p.Fee, big.NewInt(analytics.FeeDenominator))
+	if err != nil {
+		return big.NewInt(0)
+	}
+
+	return amountOut
+}
+
+// the fraction of the input left after the swap fee
+func (p *Pool) GetFeeFactor() float64 {
+	return float64(analytics.FeeDenominator-p.Fee.Int64()) / analytics.FeeDenominator
+}
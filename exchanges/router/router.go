@@ -0,0 +1,164 @@
+package router
+
+import (
+	"math/big"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/stakingagency/sa-mx-sdk-go/data"
+	"github.com/stakingagency/sa-mx-sdk-go/exchanges/onedex"
+	"github.com/stakingagency/sa-mx-sdk-go/exchanges/xexchange"
+	"github.com/stakingagency/sa-mx-sdk-go/network"
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+const (
+	defaultMaxHops = 3
+)
+
+type Hop struct {
+	Dex       string
+	Pool      string
+	TokenIn   *data.ESDT
+	TokenOut  *data.ESDT
+	AmountIn  *big.Int
+	AmountOut *big.Int
+}
+
+type Route struct {
+	TokenIn     string
+	TokenOut    string
+	AmountIn    float64
+	AmountOut   float64
+	PriceImpact float64
+	Hops        []*Hop
+}
+
+type Router struct {
+	netMan  *network.NetworkManager
+	xex     *xexchange.XExchange
+	one     *onedex.OneDex
+	maxHops int
+}
+
+var log = logger.GetOrCreate("router")
+
+func NewRouter(netMan *network.NetworkManager, xex *xexchange.XExchange, one *onedex.OneDex) (*Router, error) {
+	r := &Router{
+		netMan:  netMan,
+		xex:     xex,
+		one:     one,
+		maxHops: defaultMaxHops,
+	}
+
+	return r, nil
+}
+
+func (r *Router) SetMaxHops(maxHops int) {
+	r.maxHops = maxHops
+}
+
+func (r *Router) GetBestRoute(tokenIn string, tokenOut string, amountIn float64) (*Route, error) {
+	if tokenIn == tokenOut || r.maxHops < 1 {
+		return nil, utils.ErrInvalidRoute
+	}
+
+	pools := GetActivePools(r.xex, r.one)
+	graph := make(map[string][]*Pool)
+	var token *data.ESDT
+	for _, p := range pools {
+		graph[p.Token1.Ticker] = append(graph[p.Token1.Ticker], p)
+		graph[p.Token2.Ticker] = append(graph[p.Token2.Ticker], p)
+		if p.Token1.Ticker == tokenIn {
+			token = p.Token1
+		}
+		if p.Token2.Ticker == tokenIn {
+			token = p.Token2
+		}
+	}
+	if token == nil {
+		return nil, utils.ErrNoRouteFound
+	}
+
+	iAmountIn := utils.Renominate(amountIn, int(token.Decimals))
+	var best []*Hop
+	visited := map[string]bool{tokenIn: true}
+	var search func(current *data.ESDT, amount *big.Int, hops []*Hop)
+	search = func(current *data.ESDT, amount *big.Int, hops []*Hop) {
+		for _, p := range graph[current.Ticker] {
+			next := p.Token2
+			if p.Token2.Ticker == current.Ticker {
+				next = p.Token1
+			}
+			if visited[next.Ticker] {
+				continue
+			}
+
+			amountOut := p.GetAmountOut(current.Ticker, amount)
+			if amountOut.Sign() <= 0 {
+				continue
+			}
+
+			hop := &Hop{
+				Dex:       p.Dex,
+				Pool:      p.ID,
+				TokenIn:   current,
+				TokenOut:  next,
+				AmountIn:  amount,
+				AmountOut: amountOut,
+			}
+			path := append(append(make([]*Hop, 0, len(hops)+1), hops...), hop)
+			if next.Ticker == tokenOut {
+				if best == nil || amountOut.Cmp(best[len(best)-1].AmountOut) > 0 {
+					best = path
+				}
+				continue
+			}
+
+			if len(path) < r.maxHops {
+				visited[next.Ticker] = true
+				search(next, amountOut, path)
+				visited[next.Ticker] = false
+			}
+		}
+	}
+	search(token, iAmountIn, make([]*Hop, 0))
+	if best == nil {
+		return nil, utils.ErrNoRouteFound
+	}
+
+	return newRoute(best, pools), nil
+}
+
+func newRoute(hops []*Hop, pools []*Pool) *Route {
+	first := hops[0]
+	last := hops[len(hops)-1]
+	route := &Route{
+		TokenIn:   first.TokenIn.Ticker,
+		TokenOut:  last.TokenOut.Ticker,
+		AmountIn:  utils.Denominate(first.AmountIn, int(first.TokenIn.Decimals)),
+		AmountOut: utils.Denominate(last.AmountOut, int(last.TokenOut.Decimals)),
+		Hops:      hops,
+	}
+
+	// the spot output is the amount received at the current prices, after fees, without the reserves change
+	spotOut := new(big.Float).SetInt(first.AmountIn)
+	for _, hop := range hops {
+		for _, p := range pools {
+			if p.Dex != hop.Dex || p.ID != hop.Pool {
+				continue
+			}
+
+			reserveIn, reserveOut := p.GetReserves(hop.TokenIn.Ticker)
+			spotOut.Mul(spotOut, new(big.Float).SetInt(reserveOut))
+			spotOut.Quo(spotOut, new(big.Float).SetInt(reserveIn))
+			spotOut.Mul(spotOut, big.NewFloat(p.GetFeeFactor()))
+			break
+		}
+	}
+	if spotOut.Sign() > 0 {
+		ratio, _ := new(big.Float).Quo(new(big.Float).SetInt(last.AmountOut), spotOut).Float64()
+		route.PriceImpact = (1 - ratio) * 100
+	}
+
+	return route
+}
@@ -0,0 +1,126 @@
+package router
+
+import (
+	"math/big"
+
+	"github.com/stakingagency/sa-mx-sdk-go/exchanges/xexchange"
+	"github.com/stakingagency/sa-mx-sdk-go/network"
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+const (
+	maxSlippage = float64(100)
+)
+
+type SwapResult struct {
+	Route     *Route
+	TxHashes  []string
+	AmountOut float64
+}
+
+func (r *Router) Swap(pk []byte, tokenIn string, tokenOut string, amountIn float64, slippage float64) (*SwapResult, error) {
+	route, err := r.GetBestRoute(tokenIn, tokenOut, amountIn)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.ExecuteRoute(pk, route, slippage)
+}
+
+func (r *Router) ExecuteRoute(pk []byte, route *Route, slippage float64) (*SwapResult, error) {
+	if route == nil || len(route.Hops) == 0 {
+		return nil, utils.ErrInvalidRoute
+	}
+
+	if slippage < 0 || slippage >= maxSlippage {
+		return nil, utils.ErrInvalidSlippage
+	}
+
+	sender, err := network.GetAddressFromPrivateKey(pk)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SwapResult{
+		Route:    route,
+		TxHashes: make([]string, 0),
+	}
+	amountIn := route.Hops[0].AmountIn
+	for _, segment := range splitSegments(route.Hops) {
+		first := segment[0]
+		last := segment[len(segment)-1]
+
+		// the expected output is scaled with the amount actually received from the previous segment
+		expectedOut := big.NewInt(0).Mul(last.AmountOut, amountIn)
+		expectedOut.Quo(expectedOut, first.AmountIn)
+		minOut := utils.ApplySlippage(expectedOut, slippage, false)
+
+		var hash string
+		switch first.Dex {
+		case utils.DexXExchange:
+			hash, err = r.swapXExchange(pk, segment, amountIn, minOut)
+		case utils.DexOneDex:
+			hash, err = r.swapOneDex(pk, segment, amountIn, minOut)
+		default:
+			err = utils.ErrInvalidRoute
+		}
+		if hash != "" {
+			result.TxHashes = append(result.TxHashes, hash)
+		}
+		if err != nil {
+			return result, err
+		}
+
+		received, err := r.netMan.GetTxReceivedAmounts(hash, sender)
+		if err != nil {
+			return result, err
+		}
+
+		amountIn = received[last.TokenOut.Ticker]
+		if amountIn == nil {
+			return result, utils.ErrEventNotFound
+		}
+	}
+	last := route.Hops[len(route.Hops)-1]
+	result.AmountOut = utils.Denominate(amountIn, int(last.TokenOut.Decimals))
+
+	return result, nil
+}
+
+func (r *Router) swapXExchange(pk []byte, hops []*Hop, amountIn *big.Int, minOut *big.Int) (string, error) {
+	steps := make([]*xexchange.SwapStep, 0, len(hops))
+	for i, hop := range hops {
+		step := &xexchange.SwapStep{
+			PairAddress:  hop.Pool,
+			TokenOut:     hop.TokenOut.Ticker,
+			MinAmountOut: big.NewInt(1),
+		}
+		if i == len(hops)-1 {
+			step.MinAmountOut = minOut
+		}
+		steps = append(steps, step)
+	}
+
+	return r.xex.MultiPairSwap(pk, hops[0].TokenIn.Ticker, amountIn, steps)
+}
+
+func (r *Router) swapOneDex(pk []byte, hops []*Hop, amountIn *big.Int, minOut *big.Int) (string, error) {
+	path := []string{hops[0].TokenIn.Ticker}
+	for _, hop := range hops {
+		path = append(path, hop.TokenOut.Ticker)
+	}
+
+	return r.one.SwapMultiTokensFixedInput(pk, path, amountIn, minOut)
+}
+
+func splitSegments(hops []*Hop) [][]*Hop {
+	segments := make([][]*Hop, 0)
+	for i, hop := range hops {
+		if i == 0 || hops[i-1].Dex != hop.Dex {
+			segments = append(segments, make([]*Hop, 0))
+		}
+		segments[len(segments)-1] = append(segments[len(segments)-1], hop)
+	}
+
+	return segments
+}
@@ -0,0 +1,122 @@
+package xexchange
+
+import (
+	"encoding/base64"
+	"time"
+
+	"github.com/stakingagency/sa-mx-sdk-go/data"
+	"github.com/stakingagency/sa-mx-sdk-go/exchanges/analytics"
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+func (xex *XExchange) GetSwapEvents(pair *DexPair, from time.Time, to time.Time) ([]*data.SwapEvent, error) {
+	query := map[string]interface{}{
+		"bool": map[string]interface{}{
+			"must": []interface{}{
+				map[string]interface{}{"match": map[string]string{"events.address": pair.ContractAddress}},
+				map[string]interface{}{"range": map[string]interface{}{"timestamp": map[string]int64{"gte": from.Unix(), "lte": to.Unix()}}},
+			},
+		},
+	}
+	logs, err := xex.netMan.SearchIndexer("logs", query, nil)
+	if err != nil {
+		log.Error("search indexer", "error", err, "function", "GetSwapEvents")
+		return nil, err
+	}
+
+	res := make([]*data.SwapEvent, 0)
+	for _, entry := range logs {
+		for _, event := range entry.Source.Events {
+			if event.Address != pair.ContractAddress {
+				continue
+			}
+
+			if event.Identifier != "swapTokensFixedInput" && event.Identifier != "swapTokensFixedOutput" {
+				continue
+			}
+
+			swapEvent, ok := parseSwapEvent(event.Data)
+			if !ok {
+				log.Debug("parse swap event", "error", "can not decode event", "hash", entry.Hash, "function", "GetSwapEvents")
+				continue
+			}
+
+			swapEvent.TxHash = entry.Hash
+			if swapEvent.Timestamp == 0 {
+				swapEvent.Timestamp = entry.Source.Timestamp
+			}
+			res = append(res, swapEvent)
+		}
+	}
+
+	return res, nil
+}
+
+func (xex *XExchange) GetTWAP(pair *DexPair, window time.Duration) (float64, error) {
+	to := time.Now()
+	from := to.Add(-window)
+	events, err := xex.GetSwapEvents(pair, from, to)
+	if err != nil {
+		return 0, err
+	}
+
+	return analytics.GetTWAP(events, pair.Token1, pair.Token2, from.Unix(), to.Unix())
+}
+
+func (xex *XExchange) GetVWAP(pair *DexPair, window time.Duration) (float64, error) {
+	to := time.Now()
+	from := to.Add(-window)
+	events, err := xex.GetSwapEvents(pair, from, to)
+	if err != nil {
+		return 0, err
+	}
+
+	return analytics.GetVWAP(events, pair.Token1, pair.Token2, from.Unix(), to.Unix())
+}
+
+func parseSwapEvent(eventData string) (*data.SwapEvent, bool) {
+	bytes, err := base64.StdEncoding.DecodeString(eventData)
+	if err != nil {
+		return nil, false
+	}
+
+	// caller, token in, amount in, token out, amount out, fee, reserve in, reserve out, block, epoch, timestamp
+	_, idx, ok := utils.ParsePubkey(bytes, 0)
+	allOk := ok
+	tokenIn, idx, ok := utils.ParseString(bytes, idx)
+	allOk = allOk && ok
+	amountIn, idx, ok := utils.ParseBigInt(bytes, idx)
+	allOk = allOk && ok
+	tokenOut, idx, ok := utils.ParseString(bytes, idx)
+	allOk = allOk && ok
+	amountOut, idx, ok := utils.ParseBigInt(bytes, idx)
+	allOk = allOk && ok
+	_, idx, ok = utils.ParseBigInt(bytes, idx)
+	allOk = allOk && ok
+	reserveIn, idx, ok := utils.ParseBigInt(bytes, idx)
+	allOk = allOk && ok
+	reserveOut, idx, ok := utils.ParseBigInt(bytes, idx)
+	allOk = allOk && ok
+	if !allOk {
+		return nil, false
+	}
+
+	swapEvent := &data.SwapEvent{
+		TokenIn:    tokenIn,
+		TokenOut:   tokenOut,
+		AmountIn:   amountIn,
+		AmountOut:  amountOut,
+		ReserveIn:  reserveIn,
+		ReserveOut: reserveOut,
+	}
+	_, idx, ok = utils.ParseUint64(bytes, idx)
+	allOk = ok
+	_, idx, ok = utils.ParseUint64(bytes, idx)
+	allOk = allOk && ok
+	timestamp, _, ok := utils.ParseUint64(bytes, idx)
+	if allOk && ok {
+		swapEvent.Timestamp = int64(timestamp)
+	}
+
+	return swapEvent, true
+}
@@ -4,9 +4,12 @@ import (
 	"math/big"
 
 	"github.com/stakingagency/sa-mx-sdk-go/data"
+	"github.com/stakingagency/sa-mx-sdk-go/exchanges/analytics"
 	"github.com/stakingagency/sa-mx-sdk-go/utils"
 )
 
+var feeDenominator = big.NewInt(analytics.FeeDenominator)
+
 type DexPair struct {
 	ContractAddress string
 	State           bool
@@ -15,6 +18,8 @@ type DexPair struct {
 	Balance1        *big.Int
 	Balance2        *big.Int
 	Fee             *big.Int
+	LpToken         string
+	LpSupply        *big.Int
 }
 
 func (pair *DexPair) GetPrice() float64 {
@@ -40,3 +45,121 @@ func (pair *DexPair) GetPrice() float64 {
 
 	return balance2 / balance1
 }
+
+func (pair *DexPair) GetAmountOut(tokenIn string, amountIn *big.Int) (*big.Int, error) {
+	reserveIn, reserveOut, err := pair.getReserves(tokenIn)
+	if err != nil {
+		return nil, err
+	}
+
+	return analytics.GetAmountOut(reserveIn, reserveOut, amountIn, pair.GetFee(), feeDenominator)
+}
+
+func (pair *DexPair) GetAmountIn(tokenOut string, amountOut *big.Int) (*big.Int, error) {
+	tokenIn, err := pair.GetOtherToken(tokenOut)
+	if err != nil {
+		return nil, err
+	}
+
+	reserveIn, reserveOut, err := pair.getReserves(tokenIn.Ticker)
+	if err != nil {
+		return nil, err
+	}
+
+	return analytics.GetAmountIn(reserveIn, reserveOut, amountOut, pair.GetFee(), feeDenominator)
+}
+
+func (pair *DexPair) GetPriceImpact(tokenIn string, amountIn *big.Int) (float64, error) {
+	reserveIn, reserveOut, err := pair.getReserves(tokenIn)
+	if err != nil {
+		return 0, err
+	}
+
+	return analytics.GetPriceImpact(reserveIn, reserveOut, amountIn, pair.GetFee(), feeDenominator)
+}
+
+func (pair *DexPair) GetDepth(tokenIn string, percent float64) (*big.Int, error) {
+	reserveIn, reserveOut, err := pair.getReserves(tokenIn)
+	if err != nil {
+		return nil, err
+	}
+
+	return analytics.GetDepth(reserveIn, reserveOut, percent, pair.GetFee(), feeDenominator)
+}
+
+func (pair *DexPair) GetToken(ticker string) (*data.ESDT, error) {
+	if pair.Token1 != nil && pair.Token1.Ticker == ticker {
+		return pair.Token1, nil
+	}
+	if pair.Token2 != nil && pair.Token2.Ticker == ticker {
+		return pair.Token2, nil
+	}
+
+	return nil, utils.ErrTokenNotInPair
+}
+
+func (pair *DexPair) GetOtherToken(ticker string) (*data.ESDT, error) {
+	if pair.Token1 == nil || pair.Token2 == nil {
+		return nil, utils.ErrTokenNotInPair
+	}
+
+	switch ticker {
+	case pair.Token1.Ticker:
+		return pair.Token2, nil
+	case pair.Token2.Ticker:
+		return pair.Token1, nil
+	}
+
+	return nil, utils.ErrTokenNotInPair
+}
+
+func (pair *DexPair) getReserves(tokenIn string) (*big.Int, *big.Int, error) {
+	if pair.Token1 == nil || pair.Token2 == nil || pair.Balance1 == nil || pair.Balance2 == nil {
+		return nil, nil, utils.ErrInsufficientLiquidity
+	}
+
+	switch tokenIn {
+	case pair.Token1.Ticker:
+		return pair.Balance1, pair.Balance2, nil
+	case pair.Token2.Ticker:
+		return pair.Balance2, pair.Balance1, nil
+	}
+
+	return nil, nil, utils.ErrTokenNotInPair
+}
+
+func (pair *DexPair) GetFee() *big.Int {
+	if pair.Fee == nil {
+		return big.NewInt(0)
+	}
+
+	return pair.Fee
+}
+
+func (pair *DexPair) GetOptimalAmount(tokenIn string, amountIn *big.Int) (*big.Int, error) {
+	reserveIn, reserveOut, err := pair.getReserves(tokenIn)
+	if err != nil {
+		return nil, err
+	}
+
+	if reserveIn.Sign() == 0 || reserveOut.Sign() == 0 {
+		return nil, utils.ErrInsufficientLiquidity
+	}
+
+	amountOut := big.NewInt(0).Mul(amountIn, reserveOut)
+
+	return amountOut.Quo(amountOut, reserveIn), nil
+}
+
+func (pair *DexPair) GetLiquidityAmounts(lpAmount *big.Int) (*big.Int, *big.Int, error) {
+	if pair.LpSupply == nil || pair.LpSupply.Sign() == 0 || pair.Balance1 == nil || pair.Balance2 == nil {
+		return nil, nil, utils.ErrInsufficientLiquidity
+	}
+
+	amount1 := big.NewInt(0).Mul(lpAmount, pair.Balance1)
+	amount1.Quo(amount1, pair.LpSupply)
+	amount2 := big.NewInt(0).Mul(lpAmount, pair.Balance2)
+	amount2.Quo(amount2, pair.LpSupply)
+
+	return amount1, amount2, nil
+}
@@ -0,0 +1,165 @@
+package xexchange
+
+import (
+	"math/big"
+
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+type EnergyFactory struct {
+	ContractAddress string
+	LockedToken     string
+	BaseAssetToken  string
+	LockOptions     []*LockOption
+	Paused          bool
+}
+
+type LockOption struct {
+	LockEpochs             uint64
+	PenaltyStartPercentage uint64
+}
+
+type Energy struct {
+	Amount            *big.Int
+	LastUpdateEpoch   uint64
+	TotalLockedTokens *big.Int
+	LockedTokens      []*LockedToken
+}
+
+type LockedToken struct {
+	Collection    string
+	Nonce         uint64
+	Amount        *big.Int
+	OriginalToken string
+	UnlockEpoch   uint64
+}
+
+func (xex *XExchange) GetEnergyFactory() (*EnergyFactory, error) {
+	factory := &EnergyFactory{
+		ContractAddress: utils.EnergyFactorySC,
+		LockOptions:     make([]*LockOption, 0),
+	}
+
+	var err error
+	factory.LockedToken, err = queryString(xex.netMan, utils.EnergyFactorySC, "getLockedTokenId")
+	if err != nil {
+		return nil, err
+	}
+
+	factory.BaseAssetToken, err = queryString(xex.netMan, utils.EnergyFactorySC, "getBaseAssetTokenId")
+	if err != nil {
+		return nil, err
+	}
+
+	paused, err := xex.netMan.QueryScIntResult(utils.EnergyFactorySC, "isPaused", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	factory.Paused = paused.Uint64() == 1
+	res, err := xex.netMan.QuerySC(utils.EnergyFactorySC, "getLockOptions", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, bytes := range res.Data.ReturnData {
+		epochs, idx, ok := utils.ParseUint64(bytes, 0)
+		allOk := ok
+		penalty, _, ok := utils.ParseUint64(bytes, idx)
+		allOk = allOk && ok
+		if !allOk {
+			return nil, utils.ErrInvalidResponse
+		}
+
+		factory.LockOptions = append(factory.LockOptions, &LockOption{
+			LockEpochs:             epochs,
+			PenaltyStartPercentage: penalty,
+		})
+	}
+
+	return factory, nil
+}
+
+func (xex *XExchange) GetEnergy(address string) (*Energy, error) {
+	sAddress, err := utils.AddressArg(address)
+	if err != nil {
+		return nil, err
+	}
+
+	energy := &Energy{
+		Amount:            big.NewInt(0),
+		TotalLockedTokens: big.NewInt(0),
+		LockedTokens:      make([]*LockedToken, 0),
+	}
+
+	// the current energy amount already takes into account the energy decrease since the last update
+	energy.Amount, err = xex.netMan.QueryScIntResult(utils.EnergyFactorySC, "getEnergyAmountForUser", []string{sAddress})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := xex.netMan.QuerySC(utils.EnergyFactorySC, "getEnergyEntryForUser", []string{sAddress})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res.Data.ReturnData) > 0 && len(res.Data.ReturnData[0]) > 0 {
+		bytes := res.Data.ReturnData[0]
+		_, idx, ok := utils.ParseBigInt(bytes, 0)
+		allOk := ok
+		energy.LastUpdateEpoch, idx, ok = utils.ParseUint64(bytes, idx)
+		allOk = allOk && ok
+		energy.TotalLockedTokens, _, ok = utils.ParseBigInt(bytes, idx)
+		allOk = allOk && ok
+		if !allOk {
+			return nil, utils.ErrInvalidResponse
+		}
+	}
+
+	energy.LockedTokens, err = xex.GetLockedTokens(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return energy, nil
+}
+
+func (xex *XExchange) GetLockedTokens(address string) ([]*LockedToken, error) {
+	lockedToken, err := queryString(xex.netMan, utils.EnergyFactorySC, "getLockedTokenId")
+	if err != nil {
+		return nil, err
+	}
+
+	nfts, err := xex.getUserNFTs(address)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*LockedToken, 0)
+	for _, nft := range nfts {
+		if nft.Collection != lockedToken {
+			continue
+		}
+
+		originalToken, idx, ok := utils.ParseString(nft.Attributes, 0)
+		allOk := ok
+		_, idx, ok = utils.ParseUint64(nft.Attributes, idx)
+		allOk = allOk && ok
+		unlockEpoch, _, ok := utils.ParseUint64(nft.Attributes, idx)
+		allOk = allOk && ok
+		if !allOk {
+			log.Debug("parse locked token attributes", "error", "can not decode attributes", "identifier", nft.Identifier, "function", "GetLockedTokens")
+			continue
+		}
+
+		res = append(res, &LockedToken{
+			Collection:    nft.Collection,
+			Nonce:         nft.Nonce,
+			Amount:        nft.Quantity,
+			OriginalToken: originalToken,
+			UnlockEpoch:   unlockEpoch,
+		})
+	}
+
+	return res, nil
+}
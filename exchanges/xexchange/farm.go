@@ -0,0 +1,83 @@
+package xexchange
+
+import (
+	"math/big"
+
+	"github.com/stakingagency/sa-mx-sdk-go/data"
+)
+
+const (
+	FarmInactive      = byte(0)
+	FarmActive        = byte(1)
+	FarmPartialActive = byte(2)
+
+	maxPercentage = 10000
+	lpDecimals    = 18
+)
+
+type Farm struct {
+	ContractAddress        string
+	State                  byte
+	Pair                   *DexPair
+	FarmingToken           string
+	FarmToken              string
+	RewardToken            *data.ESDT
+	PerBlockReward         *big.Int
+	FarmTokenSupply        *big.Int
+	RewardPerShare         *big.Int
+	RewardReserve          *big.Int
+	DivisionSafetyConstant *big.Int
+	MinimumFarmingEpochs   uint64
+	PenaltyPercent         uint64
+	BoostedYieldsPercent   uint64
+	BoostedYieldsFactors   *BoostedYieldsFactors
+	CurrentWeek            uint32
+	EnergyFactoryAddress   string
+}
+
+type BoostedYieldsFactors struct {
+	MaxRewardsFactor       *big.Int
+	UserRewardsEnergyConst *big.Int
+	UserRewardsFarmConst   *big.Int
+	MinEnergyAmount        *big.Int
+	MinFarmAmount          *big.Int
+}
+
+type FarmPosition struct {
+	Farm              *Farm
+	Nonce             uint64
+	Amount            *big.Int
+	RewardPerShare    *big.Int
+	EnteringEpoch     uint64
+	CompoundedReward  *big.Int
+	CurrentFarmAmount *big.Int
+	OriginalOwner     string
+	PendingRewards    *big.Int
+}
+
+type FarmAPR struct {
+	BaseAPR    float64
+	BoostedAPR float64
+	TotalAPR   float64
+}
+
+// rewards per block going to the base (not boosted) yields
+func (farm *Farm) GetBaseRewardPerBlock() *big.Int {
+	if farm.PerBlockReward == nil {
+		return big.NewInt(0)
+	}
+
+	res := big.NewInt(0).Mul(farm.PerBlockReward, big.NewInt(int64(maxPercentage-farm.BoostedYieldsPercent)))
+
+	return res.Quo(res, big.NewInt(maxPercentage))
+}
+
+func (farm *Farm) GetBoostedRewardPerBlock() *big.Int {
+	if farm.PerBlockReward == nil {
+		return big.NewInt(0)
+	}
+
+	res := big.NewInt(0).Mul(farm.PerBlockReward, big.NewInt(int64(farm.BoostedYieldsPercent)))
+
+	return res.Quo(res, big.NewInt(maxPercentage))
+}
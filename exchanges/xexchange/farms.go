@@ -0,0 +1,498 @@
+package xexchange
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/stakingagency/sa-mx-sdk-go/accounts"
+	"github.com/stakingagency/sa-mx-sdk-go/data"
+	"github.com/stakingagency/sa-mx-sdk-go/network"
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+const (
+	enterFarmGasLimit = uint64(30000000)
+	claimFarmGasLimit = uint64(30000000)
+	exitFarmGasLimit  = uint64(40000000)
+
+	scAddressPrefix = "0000000000000000"
+	secondsPerYear  = 365 * 24 * 3600
+	secondsPerWeek  = 7 * 24 * 3600
+)
+
+// farms and metastaking contracts are not registered anywhere, so they are discovered from the owners
+// of the MetaESDT collections, which are the contracts that registered their farm / dual yield tokens
+func (xex *XExchange) getFarmCandidates() ([]string, error) {
+	query := map[string]interface{}{
+		"match": map[string]string{"type": "MetaESDT"},
+	}
+	entries, err := xex.netMan.SearchIndexer("tokens", query, nil)
+	if err != nil {
+		log.Error("search indexer", "error", err, "function", "getFarmCandidates")
+		return nil, err
+	}
+
+	conv, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	owners := make(map[string]bool)
+	res := make([]string, 0)
+	for _, entry := range entries {
+		owner := entry.Source.CurrentOwner
+		if owner == "" || owners[owner] {
+			continue
+		}
+
+		pubkey, err := conv.Decode(owner)
+		if err != nil || !strings.HasPrefix(hex.EncodeToString(pubkey), scAddressPrefix) {
+			continue
+		}
+
+		owners[owner] = true
+		res = append(res, owner)
+	}
+
+	return res, nil
+}
+
+func (xex *XExchange) GetFarms() (map[string]*Farm, error) {
+	candidates, err := xex.getFarmCandidates()
+	if err != nil {
+		return nil, err
+	}
+
+	return xex.getFarmsFromCandidates(candidates)
+}
+
+func (xex *XExchange) getFarmsFromCandidates(candidates []string) (map[string]*Farm, error) {
+	pairs, err := xex.getPairs()
+	if err != nil {
+		return nil, err
+	}
+
+	lpTokens := make(map[string]*DexPair)
+	for _, pair := range pairs {
+		lpTokens[pair.LpToken] = pair
+	}
+
+	res := make(map[string]*Farm)
+	for _, address := range candidates {
+		farmingToken, err := queryString(xex.netMan, address, "getFarmingTokenId")
+		if err != nil || lpTokens[farmingToken] == nil {
+			continue
+		}
+
+		farm, err := xex.getFarmData(address, lpTokens[farmingToken])
+		if err != nil {
+			log.Debug("get farm data", "error", err, "address", address, "function", "getFarmsFromCandidates")
+			continue
+		}
+
+		res[address] = farm
+	}
+
+	return res, nil
+}
+
+func (xex *XExchange) GetCachedFarms() (map[string]*Farm, error) {
+	if xex.refreshInterval == utils.NoRefresh {
+		return nil, utils.ErrRefreshIntervalNotSet
+	}
+
+	res := make(map[string]*Farm)
+	xex.cachedFarmsMut.Lock()
+	for k, v := range xex.cachedFarms {
+		res[k] = v
+	}
+	xex.cachedFarmsMut.Unlock()
+
+	return res, nil
+}
+
+func (xex *XExchange) GetFarm(contractAddress string) (*Farm, error) {
+	farmingToken, err := queryString(xex.netMan, contractAddress, "getFarmingTokenId")
+	if err != nil {
+		return nil, err
+	}
+
+	pairs, err := xex.getPairs()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pair := range pairs {
+		if pair.LpToken == farmingToken {
+			return xex.getFarmData(contractAddress, pair)
+		}
+	}
+
+	return nil, utils.ErrPairNotFound
+}
+
+func (xex *XExchange) getFarmData(contractAddress string, pair *DexPair) (*Farm, error) {
+	farm := &Farm{
+		ContractAddress: contractAddress,
+		Pair:            pair,
+		FarmingToken:    pair.LpToken,
+	}
+
+	state, err := xex.netMan.QueryScIntResult(contractAddress, "getState", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	farm.State = byte(state.Uint64())
+	farm.FarmToken, err = queryString(xex.netMan, contractAddress, "getFarmTokenId")
+	if err != nil {
+		return nil, err
+	}
+
+	rewardToken, err := queryString(xex.netMan, contractAddress, "getRewardTokenId")
+	if err != nil {
+		return nil, err
+	}
+
+	farm.RewardToken, err = xex.getToken(rewardToken)
+	if err != nil {
+		return nil, err
+	}
+
+	ints := map[string]**big.Int{
+		"getPerBlockRewardAmount":   &farm.PerBlockReward,
+		"getFarmTokenSupply":        &farm.FarmTokenSupply,
+		"getRewardPerShare":         &farm.RewardPerShare,
+		"getRewardReserve":          &farm.RewardReserve,
+		"getDivisionSafetyConstant": &farm.DivisionSafetyConstant,
+	}
+	for funcName, value := range ints {
+		*value, err = xex.netMan.QueryScIntResult(contractAddress, funcName, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	uints := map[string]*uint64{
+		"getMinimumFarmingEpoch":            &farm.MinimumFarmingEpochs,
+		"getPenaltyPercent":                 &farm.PenaltyPercent,
+		"getBoostedYieldsRewardsPercentage": &farm.BoostedYieldsPercent,
+	}
+	for funcName, value := range uints {
+		iValue, err := xex.netMan.QueryScIntResult(contractAddress, funcName, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		*value = iValue.Uint64()
+	}
+
+	week, err := xex.netMan.QueryScIntResult(contractAddress, "getCurrentWeek", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	farm.CurrentWeek = uint32(week.Uint64())
+	farm.BoostedYieldsFactors, err = xex.getBoostedYieldsFactors(contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	farm.EnergyFactoryAddress, err = xex.netMan.QueryScAddressResult(contractAddress, "getEnergyFactoryAddress", nil)
+	if err != nil || farm.EnergyFactoryAddress == "" {
+		farm.EnergyFactoryAddress = utils.EnergyFactorySC
+	}
+
+	return farm, nil
+}
+
+func (xex *XExchange) getBoostedYieldsFactors(contractAddress string) (*BoostedYieldsFactors, error) {
+	res, err := xex.netMan.QuerySC(contractAddress, "getBoostedYieldsFactors", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	factors := &BoostedYieldsFactors{}
+	if len(res.Data.ReturnData) == 0 || len(res.Data.ReturnData[0]) == 0 {
+		return factors, nil
+	}
+
+	bytes := res.Data.ReturnData[0]
+	idx := 0
+	values := []**big.Int{&factors.MaxRewardsFactor, &factors.UserRewardsEnergyConst, &factors.UserRewardsFarmConst,
+		&factors.MinEnergyAmount, &factors.MinFarmAmount}
+	for _, value := range values {
+		var ok bool
+		*value, idx, ok = utils.ParseBigInt(bytes, idx)
+		if !ok {
+			return nil, utils.ErrInvalidResponse
+		}
+	}
+
+	return factors, nil
+}
+
+func (xex *XExchange) GetUserFarmPositions(address string) ([]*FarmPosition, error) {
+	farms, err := xex.getFarms()
+	if err != nil {
+		return nil, err
+	}
+
+	farmTokens := make(map[string]*Farm)
+	for _, farm := range farms {
+		farmTokens[farm.FarmToken] = farm
+	}
+
+	nfts, err := xex.getUserNFTs(address)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*FarmPosition, 0)
+	for _, nft := range nfts {
+		farm := farmTokens[nft.Collection]
+		if farm == nil {
+			continue
+		}
+
+		position, err := parseFarmTokenAttributes(nft.Attributes)
+		if err != nil {
+			log.Debug("parse farm token attributes", "error", err, "identifier", nft.Identifier, "function", "GetUserFarmPositions")
+			continue
+		}
+
+		position.Farm = farm
+		position.Nonce = nft.Nonce
+		position.Amount = nft.Quantity
+		position.PendingRewards, err = xex.getPendingRewards(address, position, nft.Attributes)
+		if err != nil {
+			log.Debug("get pending rewards", "error", err, "identifier", nft.Identifier, "function", "GetUserFarmPositions")
+		}
+		res = append(res, position)
+	}
+
+	return res, nil
+}
+
+func (xex *XExchange) getPendingRewards(address string, position *FarmPosition, attributes []byte) (*big.Int, error) {
+	sAddress, err := utils.AddressArg(address)
+	if err != nil {
+		return nil, err
+	}
+
+	args := []string{sAddress, utils.BigIntArg(position.Amount), hex.EncodeToString(attributes)}
+
+	return xex.netMan.QueryScIntResult(position.Farm.ContractAddress, "calculateRewardsForGivenPosition", args)
+}
+
+func parseFarmTokenAttributes(attributes []byte) (*FarmPosition, error) {
+	position := &FarmPosition{}
+	idx := 0
+	var ok bool
+	position.RewardPerShare, idx, ok = utils.ParseBigInt(attributes, idx)
+	allOk := ok
+	position.EnteringEpoch, idx, ok = utils.ParseUint64(attributes, idx)
+	allOk = allOk && ok
+	position.CompoundedReward, idx, ok = utils.ParseBigInt(attributes, idx)
+	allOk = allOk && ok
+	position.CurrentFarmAmount, idx, ok = utils.ParseBigInt(attributes, idx)
+	allOk = allOk && ok
+	owner, _, ok := utils.ParsePubkey(attributes, idx)
+	allOk = allOk && ok
+	if !allOk {
+		return nil, utils.ErrInvalidResponse
+	}
+
+	conv, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	position.OriginalOwner, _ = conv.Encode(owner)
+
+	return position, nil
+}
+
+func (xex *XExchange) EnterFarm(pk []byte, farm *Farm, amount float64) (string, error) {
+	if farm.State != FarmActive {
+		return "", utils.ErrPairNotActive
+	}
+
+	lpToken, err := xex.getToken(farm.FarmingToken)
+	if err != nil {
+		return "", err
+	}
+
+	hash, err := xex.netMan.SendEsdtTransaction(pk, farm.ContractAddress, amount, enterFarmGasLimit, lpToken, "enterFarm", nil, utils.AutoNonce)
+	if err != nil {
+		return "", err
+	}
+
+	return hash, xex.netMan.GetTxResult(hash)
+}
+
+func (xex *XExchange) ClaimFarmRewards(pk []byte, position *FarmPosition) (string, error) {
+	if position.PendingRewards != nil && position.PendingRewards.Sign() == 0 {
+		return "", utils.ErrNothingToClaim
+	}
+
+	hash, err := xex.netMan.SendEsdtNftTransaction(pk, position.Farm.ContractAddress, position.Farm.FarmToken, position.Nonce,
+		position.Amount, claimFarmGasLimit, "claimRewards", nil, utils.AutoNonce)
+	if err != nil {
+		return "", err
+	}
+
+	return hash, xex.netMan.GetTxResult(hash)
+}
+
+func (xex *XExchange) ExitFarm(pk []byte, position *FarmPosition, amount *big.Int) (string, error) {
+	if amount == nil || amount.Sign() <= 0 || amount.Cmp(position.Amount) > 0 {
+		return "", utils.ErrInsufficientStake
+	}
+
+	args := []string{utils.BigIntArg(amount)}
+	hash, err := xex.netMan.SendEsdtNftTransaction(pk, position.Farm.ContractAddress, position.Farm.FarmToken, position.Nonce,
+		position.Amount, exitFarmGasLimit, "exitFarm", args, utils.AutoNonce)
+	if err != nil {
+		return "", err
+	}
+
+	return hash, xex.netMan.GetTxResult(hash)
+}
+
+// the base APR is the same for all the farmers, while the boosted APR depends on the user's energy and farm position.
+// Prices are in the same currency (USD for example) and the farming token price is the price of one LP token
+func (xex *XExchange) GetUserFarmAPR(farm *Farm, address string, rewardTokenPrice float64, farmingTokenPrice float64) (*FarmAPR, error) {
+	res := &FarmAPR{}
+	cfg := xex.netMan.GetNetworkConfig()
+	if cfg == nil || cfg.RoundDuration == 0 || farm.FarmTokenSupply == nil || farm.FarmTokenSupply.Sign() == 0 || farmingTokenPrice == 0 {
+		return res, nil
+	}
+
+	blocksPerYear := float64(secondsPerYear*1000) / float64(cfg.RoundDuration)
+	decimals := int(farm.RewardToken.Decimals)
+	farmSupplyValue := utils.Denominate(farm.FarmTokenSupply, lpDecimals) * farmingTokenPrice
+	baseRewards := utils.Denominate(farm.GetBaseRewardPerBlock(), decimals) * blocksPerYear * rewardTokenPrice
+	res.BaseAPR = baseRewards / farmSupplyValue * 100
+	res.TotalAPR = res.BaseAPR
+
+	if address == "" || farm.BoostedYieldsPercent == 0 || farm.BoostedYieldsFactors == nil {
+		return res, nil
+	}
+
+	positions, err := xex.GetUserFarmPositions(address)
+	if err != nil {
+		return nil, err
+	}
+
+	userFarmAmount := big.NewInt(0)
+	for _, position := range positions {
+		if position.Farm.ContractAddress == farm.ContractAddress {
+			userFarmAmount.Add(userFarmAmount, position.Amount)
+		}
+	}
+	if userFarmAmount.Sign() == 0 {
+		return res, nil
+	}
+
+	energy, err := xex.GetEnergy(address)
+	if err != nil {
+		return nil, err
+	}
+
+	weeklyRewards := big.NewInt(0).Mul(farm.GetBoostedRewardPerBlock(), big.NewInt(int64(float64(secondsPerWeek*1000)/float64(cfg.RoundDuration))))
+	userRewards, err := xex.getUserBoostedRewards(farm, weeklyRewards, energy.Amount, userFarmAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	userValue := utils.Denominate(userFarmAmount, lpDecimals) * farmingTokenPrice
+	weeksPerYear := float64(secondsPerYear) / float64(secondsPerWeek)
+	res.BoostedAPR = utils.Denominate(userRewards, decimals) * weeksPerYear * rewardTokenPrice / userValue * 100
+	res.TotalAPR += res.BoostedAPR
+
+	return res, nil
+}
+
+func (xex *XExchange) getUserBoostedRewards(farm *Farm, weeklyRewards *big.Int, energy *big.Int, userFarmAmount *big.Int) (*big.Int, error) {
+	factors := farm.BoostedYieldsFactors
+	if energy.Cmp(factors.MinEnergyAmount) < 0 || userFarmAmount.Cmp(factors.MinFarmAmount) < 0 {
+		return big.NewInt(0), nil
+	}
+
+	week := utils.Uint64Arg(uint64(farm.CurrentWeek))
+	totalEnergy, err := xex.netMan.QueryScIntResult(farm.ContractAddress, "getTotalEnergyForWeek", []string{week})
+	if err != nil {
+		return nil, err
+	}
+
+	farmSupply, err := xex.netMan.QueryScIntResult(farm.ContractAddress, "getFarmSupplyForWeek", []string{week})
+	if err != nil {
+		return nil, err
+	}
+
+	if farmSupply.Sign() == 0 {
+		farmSupply = farm.FarmTokenSupply
+	}
+	if totalEnergy.Sign() == 0 || farmSupply.Sign() == 0 {
+		return big.NewInt(0), nil
+	}
+
+	// same formula as the farm contract
+	maxRewards := big.NewInt(0).Mul(factors.MaxRewardsFactor, weeklyRewards)
+	maxRewards.Mul(maxRewards, userFarmAmount)
+	maxRewards.Quo(maxRewards, farmSupply)
+
+	rewardsByEnergy := big.NewInt(0).Mul(weeklyRewards, factors.UserRewardsEnergyConst)
+	rewardsByEnergy.Mul(rewardsByEnergy, energy)
+	rewardsByEnergy.Quo(rewardsByEnergy, totalEnergy)
+
+	rewardsByTokens := big.NewInt(0).Mul(weeklyRewards, factors.UserRewardsFarmConst)
+	rewardsByTokens.Mul(rewardsByTokens, userFarmAmount)
+	rewardsByTokens.Quo(rewardsByTokens, farmSupply)
+
+	constantsBase := big.NewInt(0).Add(factors.UserRewardsEnergyConst, factors.UserRewardsFarmConst)
+	if constantsBase.Sign() == 0 {
+		return big.NewInt(0), nil
+	}
+
+	rewards := big.NewInt(0).Add(rewardsByEnergy, rewardsByTokens)
+	rewards.Quo(rewards, constantsBase)
+	if rewards.Cmp(maxRewards) > 0 {
+		return maxRewards, nil
+	}
+
+	return rewards, nil
+}
+
+func (xex *XExchange) getPairs() (map[string]*DexPair, error) {
+	if xex.refreshInterval == utils.NoRefresh {
+		return xex.GetDexPairs()
+	}
+
+	return xex.GetCachedDexPairs()
+}
+
+func (xex *XExchange) getFarms() (map[string]*Farm, error) {
+	if xex.refreshInterval == utils.NoRefresh {
+		return xex.GetFarms()
+	}
+
+	return xex.GetCachedFarms()
+}
+
+func (xex *XExchange) getUserNFTs(address string) (map[string]*data.NFT, error) {
+	account, err := accounts.NewAccount(address, xex.netMan, utils.NoRefresh)
+	if err != nil {
+		return nil, err
+	}
+
+	return account.GetNFTs()
+}
+
+func queryString(netMan *network.NetworkManager, contractAddress string, funcName string) (string, error) {
+	res, err := netMan.QuerySC(contractAddress, funcName, nil)
+	if err != nil {
+		return "", err
+	}
+
+	if len(res.Data.ReturnData) == 0 {
+		return "", utils.ErrInvalidResponse
+	}
+
+	return string(res.Data.ReturnData[0]), nil
+}
@@ -0,0 +1,164 @@
+package xexchange
+
+import (
+	"math/big"
+
+	"github.com/stakingagency/sa-mx-sdk-go/data"
+	"github.com/stakingagency/sa-mx-sdk-go/network"
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+const (
+	addLiquidityGasLimit    = uint64(20000000)
+	removeLiquidityGasLimit = uint64(20000000)
+)
+
+const LpTokenDecimals = 18
+
+func (xex *XExchange) GetOptimalLiquidityAmount(pair *DexPair, tokenIn string, amountIn float64) (float64, error) {
+	token, err := pair.GetToken(tokenIn)
+	if err != nil {
+		return 0, err
+	}
+
+	otherToken, _ := pair.GetOtherToken(tokenIn)
+	amountOut, err := pair.GetOptimalAmount(tokenIn, utils.Renominate(amountIn, int(token.Decimals)))
+	if err != nil {
+		return 0, err
+	}
+
+	return utils.Denominate(amountOut, int(otherToken.Decimals)), nil
+}
+
+func (xex *XExchange) AddLiquidity(pk []byte, pair *DexPair, amount1 float64, amount2 float64, slippage float64) (*data.LiquidityResult, error) {
+	if !pair.State {
+		return nil, utils.ErrPairNotActive
+	}
+
+	if slippage < 0 || slippage >= maxSlippage {
+		return nil, utils.ErrInvalidSlippage
+	}
+
+	if pair.Token1 == nil || pair.Token2 == nil {
+		return nil, utils.ErrTokenNotInPair
+	}
+
+	iAmount1 := utils.Renominate(amount1, int(pair.Token1.Decimals))
+	iAmount2 := utils.Renominate(amount2, int(pair.Token2.Decimals))
+	optimal2, err := pair.GetOptimalAmount(pair.Token1.Ticker, iAmount1)
+	if err != nil {
+		return nil, err
+	}
+
+	// only the optimal amounts are added to the pool, the rest is returned
+	min1 := iAmount1
+	min2 := optimal2
+	if optimal2.Cmp(iAmount2) > 0 {
+		min1, err = pair.GetOptimalAmount(pair.Token2.Ticker, iAmount2)
+		if err != nil {
+			return nil, err
+		}
+		min2 = iAmount2
+	}
+	min1 = utils.ApplySlippage(min1, slippage, false)
+	min2 = utils.ApplySlippage(min2, slippage, false)
+
+	transfers := []*data.EsdtTransfer{
+		{Ticker: pair.Token1.Ticker, Amount: iAmount1},
+		{Ticker: pair.Token2.Ticker, Amount: iAmount2},
+	}
+	args := []string{utils.BigIntArg(min1), utils.BigIntArg(min2)}
+	hash, err := xex.netMan.SendMultiEsdtTransaction(pk, pair.ContractAddress, transfers, addLiquidityGasLimit, "addLiquidity", args, utils.AutoNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &data.LiquidityResult{
+		TxHash:  hash,
+		Pair:    pair.ContractAddress,
+		Token1:  pair.Token1.Ticker,
+		Token2:  pair.Token2.Ticker,
+		LpToken: pair.LpToken,
+	}
+	received, err := xex.getLiquidityReceived(pk, hash)
+	if err != nil {
+		return result, err
+	}
+
+	if received[pair.Token1.Ticker] != nil {
+		iAmount1 = big.NewInt(0).Sub(iAmount1, received[pair.Token1.Ticker])
+	}
+	if received[pair.Token2.Ticker] != nil {
+		iAmount2 = big.NewInt(0).Sub(iAmount2, received[pair.Token2.Ticker])
+	}
+	result.Amount1 = utils.Denominate(iAmount1, int(pair.Token1.Decimals))
+	result.Amount2 = utils.Denominate(iAmount2, int(pair.Token2.Decimals))
+	if received[pair.LpToken] == nil {
+		return result, utils.ErrEventNotFound
+	}
+
+	result.LpAmount = utils.Denominate(received[pair.LpToken], LpTokenDecimals)
+
+	return result, nil
+}
+
+func (xex *XExchange) RemoveLiquidity(pk []byte, pair *DexPair, lpAmount float64, slippage float64) (*data.LiquidityResult, error) {
+	if slippage < 0 || slippage >= maxSlippage {
+		return nil, utils.ErrInvalidSlippage
+	}
+
+	if pair.LpToken == "" || pair.Token1 == nil || pair.Token2 == nil {
+		return nil, utils.ErrTokenNotInPair
+	}
+
+	iLpAmount := utils.Renominate(lpAmount, LpTokenDecimals)
+	amount1, amount2, err := pair.GetLiquidityAmounts(iLpAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	transfers := []*data.EsdtTransfer{
+		{Ticker: pair.LpToken, Amount: iLpAmount},
+	}
+	args := []string{utils.BigIntArg(utils.ApplySlippage(amount1, slippage, false)), utils.BigIntArg(utils.ApplySlippage(amount2, slippage, false))}
+	hash, err := xex.netMan.SendMultiEsdtTransaction(pk, pair.ContractAddress, transfers, removeLiquidityGasLimit, "removeLiquidity", args, utils.AutoNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &data.LiquidityResult{
+		TxHash:   hash,
+		Pair:     pair.ContractAddress,
+		Token1:   pair.Token1.Ticker,
+		Token2:   pair.Token2.Ticker,
+		LpToken:  pair.LpToken,
+		LpAmount: lpAmount,
+	}
+	received, err := xex.getLiquidityReceived(pk, hash)
+	if err != nil {
+		return result, err
+	}
+
+	if received[pair.Token1.Ticker] == nil || received[pair.Token2.Ticker] == nil {
+		return result, utils.ErrEventNotFound
+	}
+
+	result.Amount1 = utils.Denominate(received[pair.Token1.Ticker], int(pair.Token1.Decimals))
+	result.Amount2 = utils.Denominate(received[pair.Token2.Ticker], int(pair.Token2.Decimals))
+
+	return result, nil
+}
+
+func (xex *XExchange) getLiquidityReceived(pk []byte, hash string) (map[string]*big.Int, error) {
+	err := xex.netMan.GetTxResult(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, err := network.GetAddressFromPrivateKey(pk)
+	if err != nil {
+		return nil, err
+	}
+
+	return xex.netMan.GetTxReceivedAmounts(hash, sender)
+}
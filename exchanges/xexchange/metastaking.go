@@ -0,0 +1,184 @@
+package xexchange
+
+import (
+	"math/big"
+
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+type Metastaking struct {
+	ContractAddress    string
+	DualYieldToken     string
+	PairAddress        string
+	LpToken            string
+	LpFarmAddress      string
+	LpFarmToken        string
+	StakingFarmAddress string
+	StakingFarmToken   string
+	StakingToken       string
+
+	StakingFarmAPR            float64
+	StakingFarmRewardPerBlock *big.Int
+	StakingFarmSupply         *big.Int
+}
+
+type MetastakingPosition struct {
+	Metastaking            *Metastaking
+	Nonce                  uint64
+	Amount                 *big.Int
+	LpFarmTokenNonce       uint64
+	LpFarmTokenAmount      *big.Int
+	StakingFarmTokenNonce  uint64
+	StakingFarmTokenAmount *big.Int
+}
+
+func (xex *XExchange) GetMetastakings() (map[string]*Metastaking, error) {
+	candidates, err := xex.getFarmCandidates()
+	if err != nil {
+		return nil, err
+	}
+
+	return xex.getMetastakingsFromCandidates(candidates), nil
+}
+
+func (xex *XExchange) getMetastakingsFromCandidates(candidates []string) map[string]*Metastaking {
+	res := make(map[string]*Metastaking)
+	for _, address := range candidates {
+		if _, err := queryString(xex.netMan, address, "getDualYieldTokenId"); err != nil {
+			continue
+		}
+
+		metastaking, err := xex.GetMetastaking(address)
+		if err != nil {
+			log.Debug("get metastaking", "error", err, "address", address, "function", "getMetastakingsFromCandidates")
+			continue
+		}
+
+		res[address] = metastaking
+	}
+
+	return res
+}
+
+func (xex *XExchange) GetCachedMetastakings() (map[string]*Metastaking, error) {
+	if xex.refreshInterval == utils.NoRefresh {
+		return nil, utils.ErrRefreshIntervalNotSet
+	}
+
+	res := make(map[string]*Metastaking)
+	xex.cachedMetastakingsMut.Lock()
+	for k, v := range xex.cachedMetastakings {
+		res[k] = v
+	}
+	xex.cachedMetastakingsMut.Unlock()
+
+	return res, nil
+}
+
+func (xex *XExchange) GetMetastaking(contractAddress string) (*Metastaking, error) {
+	metastaking := &Metastaking{
+		ContractAddress: contractAddress,
+	}
+
+	var err error
+	tokens := map[string]*string{
+		"getDualYieldTokenId":   &metastaking.DualYieldToken,
+		"getLpTokenId":          &metastaking.LpToken,
+		"getLpFarmTokenId":      &metastaking.LpFarmToken,
+		"getStakingFarmTokenId": &metastaking.StakingFarmToken,
+		"getStakingTokenId":     &metastaking.StakingToken,
+	}
+	for funcName, value := range tokens {
+		*value, err = queryString(xex.netMan, contractAddress, funcName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	addresses := map[string]*string{
+		"getPairAddress":        &metastaking.PairAddress,
+		"getLpFarmAddress":      &metastaking.LpFarmAddress,
+		"getStakingFarmAddress": &metastaking.StakingFarmAddress,
+	}
+	for funcName, value := range addresses {
+		*value, err = xex.netMan.QueryScAddressResult(contractAddress, funcName, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	apr, err := xex.netMan.QueryScIntResult(metastaking.StakingFarmAddress, "getAnnualPercentageRewards", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	metastaking.StakingFarmAPR = float64(apr.Uint64()) * 100 / maxPercentage
+	metastaking.StakingFarmRewardPerBlock, err = xex.netMan.QueryScIntResult(metastaking.StakingFarmAddress, "getPerBlockRewardAmount", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	metastaking.StakingFarmSupply, err = xex.netMan.QueryScIntResult(metastaking.StakingFarmAddress, "getFarmTokenSupply", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return metastaking, nil
+}
+
+func (xex *XExchange) GetUserMetastakingPositions(address string) ([]*MetastakingPosition, error) {
+	metastakings, err := xex.getMetastakings()
+	if err != nil {
+		return nil, err
+	}
+
+	dualYieldTokens := make(map[string]*Metastaking)
+	for _, metastaking := range metastakings {
+		dualYieldTokens[metastaking.DualYieldToken] = metastaking
+	}
+
+	nfts, err := xex.getUserNFTs(address)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*MetastakingPosition, 0)
+	for _, nft := range nfts {
+		metastaking := dualYieldTokens[nft.Collection]
+		if metastaking == nil {
+			continue
+		}
+
+		position := &MetastakingPosition{
+			Metastaking: metastaking,
+			Nonce:       nft.Nonce,
+			Amount:      nft.Quantity,
+		}
+		idx := 0
+		var ok bool
+		position.LpFarmTokenNonce, idx, ok = utils.ParseUint64(nft.Attributes, idx)
+		allOk := ok
+		position.LpFarmTokenAmount, idx, ok = utils.ParseBigInt(nft.Attributes, idx)
+		allOk = allOk && ok
+		position.StakingFarmTokenNonce, idx, ok = utils.ParseUint64(nft.Attributes, idx)
+		allOk = allOk && ok
+		position.StakingFarmTokenAmount, _, ok = utils.ParseBigInt(nft.Attributes, idx)
+		allOk = allOk && ok
+		if !allOk {
+			log.Debug("parse dual yield token attributes", "error", "can not decode attributes", "identifier", nft.Identifier, "function", "GetUserMetastakingPositions")
+			continue
+		}
+
+		res = append(res, position)
+	}
+
+	return res, nil
+}
+
+func (xex *XExchange) getMetastakings() (map[string]*Metastaking, error) {
+	if xex.refreshInterval == utils.NoRefresh {
+		return xex.GetMetastakings()
+	}
+
+	return xex.GetCachedMetastakings()
+}
@@ -0,0 +1,215 @@
+package xexchange
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/stakingagency/sa-mx-sdk-go/data"
+	"github.com/stakingagency/sa-mx-sdk-go/network"
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+const (
+	swapGasLimit = uint64(20000000)
+	maxSlippage  = float64(100)
+)
+
+type SwapStep struct {
+	PairAddress  string
+	TokenOut     string
+	MinAmountOut *big.Int
+}
+
+func (xex *XExchange) QuoteFixedInput(pair *DexPair, tokenIn string, amountIn float64) (float64, error) {
+	token, err := pair.GetToken(tokenIn)
+	if err != nil {
+		return 0, err
+	}
+
+	otherToken, _ := pair.GetOtherToken(tokenIn)
+	amountOut, err := pair.GetAmountOut(tokenIn, utils.Renominate(amountIn, int(token.Decimals)))
+	if err != nil {
+		return 0, err
+	}
+
+	return utils.Denominate(amountOut, int(otherToken.Decimals)), nil
+}
+
+func (xex *XExchange) QuoteFixedOutput(pair *DexPair, tokenOut string, amountOut float64) (float64, error) {
+	token, err := pair.GetToken(tokenOut)
+	if err != nil {
+		return 0, err
+	}
+
+	otherToken, _ := pair.GetOtherToken(tokenOut)
+	amountIn, err := pair.GetAmountIn(tokenOut, utils.Renominate(amountOut, int(token.Decimals)))
+	if err != nil {
+		return 0, err
+	}
+
+	return utils.Denominate(amountIn, int(otherToken.Decimals)), nil
+}
+
+func (xex *XExchange) SwapFixedInput(pk []byte, pair *DexPair, tokenIn string, amountIn float64, slippage float64) (*data.SwapResult, error) {
+	if !pair.State {
+		return nil, utils.ErrPairNotActive
+	}
+
+	if slippage < 0 || slippage >= maxSlippage {
+		return nil, utils.ErrInvalidSlippage
+	}
+
+	token, err := pair.GetToken(tokenIn)
+	if err != nil {
+		return nil, err
+	}
+
+	otherToken, _ := pair.GetOtherToken(tokenIn)
+	iAmountIn := utils.Renominate(amountIn, int(token.Decimals))
+	expectedOut, err := pair.GetAmountOut(tokenIn, iAmountIn)
+	if err != nil {
+		return nil, err
+	}
+
+	minOut := utils.ApplySlippage(expectedOut, slippage, false)
+	if minOut.Sign() == 0 {
+		return nil, utils.ErrInsufficientLiquidity
+	}
+
+	args := []string{utils.StringArg(otherToken.Ticker), utils.BigIntArg(minOut)}
+	result := &data.SwapResult{
+		Pair:              pair.ContractAddress,
+		TokenIn:           token.Ticker,
+		TokenOut:          otherToken.Ticker,
+		AmountIn:          amountIn,
+		ExpectedAmountOut: utils.Denominate(expectedOut, int(otherToken.Decimals)),
+		MinAmountOut:      utils.Denominate(minOut, int(otherToken.Decimals)),
+	}
+	err = xex.sendSwap(pk, pair.ContractAddress, token, iAmountIn, "swapTokensFixedInput", args, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (xex *XExchange) SwapFixedOutput(pk []byte, pair *DexPair, tokenOut string, amountOut float64, slippage float64) (*data.SwapResult, error) {
+	if !pair.State {
+		return nil, utils.ErrPairNotActive
+	}
+
+	if slippage < 0 || slippage >= maxSlippage {
+		return nil, utils.ErrInvalidSlippage
+	}
+
+	token, err := pair.GetToken(tokenOut)
+	if err != nil {
+		return nil, err
+	}
+
+	otherToken, _ := pair.GetOtherToken(tokenOut)
+	iAmountOut := utils.Renominate(amountOut, int(token.Decimals))
+	expectedIn, err := pair.GetAmountIn(tokenOut, iAmountOut)
+	if err != nil {
+		return nil, err
+	}
+
+	maxIn := utils.ApplySlippage(expectedIn, slippage, true)
+	args := []string{utils.StringArg(token.Ticker), utils.BigIntArg(iAmountOut)}
+	result := &data.SwapResult{
+		Pair:              pair.ContractAddress,
+		TokenIn:           otherToken.Ticker,
+		TokenOut:          token.Ticker,
+		AmountIn:          utils.Denominate(maxIn, int(otherToken.Decimals)),
+		ExpectedAmountOut: amountOut,
+		MinAmountOut:      amountOut,
+	}
+	err = xex.sendSwap(pk, pair.ContractAddress, otherToken, maxIn, "swapTokensFixedOutput", args, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (xex *XExchange) sendSwap(pk []byte, receiver string, tokenIn *data.ESDT, amountIn *big.Int, function string, args []string, result *data.SwapResult) error {
+	sender, err := network.GetAddressFromPrivateKey(pk)
+	if err != nil {
+		return err
+	}
+
+	dataField := fmt.Sprintf("ESDTTransfer@%s@%s@%s", utils.StringArg(tokenIn.Ticker), utils.BigIntArg(amountIn), utils.StringArg(function))
+	for _, arg := range args {
+		dataField += "@" + arg
+	}
+	hash, err := xex.netMan.SendTransaction(pk, receiver, 0, swapGasLimit, dataField, utils.AutoNonce)
+	if err != nil {
+		return err
+	}
+
+	result.TxHash = hash
+	err = xex.netMan.GetTxResult(hash)
+	if err != nil {
+		return err
+	}
+
+	received, err := xex.netMan.GetTxReceivedAmounts(hash, sender)
+	if err != nil {
+		return err
+	}
+
+	tokenOut, err := xex.getToken(result.TokenOut)
+	if err != nil {
+		return err
+	}
+
+	iAmountOut := received[result.TokenOut]
+	if iAmountOut == nil {
+		return utils.ErrEventNotFound
+	}
+
+	// fixed output swaps refund the unused input, which is returned together with the output in a multi transfer
+	result.AmountOut = utils.Denominate(iAmountOut, int(tokenOut.Decimals))
+	refund := received[tokenIn.Ticker]
+	if refund != nil {
+		result.AmountIn = utils.Denominate(big.NewInt(0).Sub(amountIn, refund), int(tokenIn.Decimals))
+	}
+
+	return nil
+}
+
+func (xex *XExchange) getToken(ticker string) (*data.ESDT, error) {
+	if xex.refreshInterval == utils.NoRefresh {
+		return xex.mxTokens.GetTokenProperties(ticker)
+	}
+
+	return xex.mxTokens.GetCachedTokenProperties(ticker)
+}
+
+func (xex *XExchange) MultiPairSwap(pk []byte, tokenIn string, amountIn *big.Int, steps []*SwapStep) (string, error) {
+	if len(steps) == 0 {
+		return "", utils.ErrInvalidRoute
+	}
+
+	dataField := fmt.Sprintf("ESDTTransfer@%s@%s@%s", utils.StringArg(tokenIn), utils.BigIntArg(amountIn), utils.StringArg("multiPairSwap"))
+	for _, step := range steps {
+		sPair, err := utils.AddressArg(step.PairAddress)
+		if err != nil {
+			return "", err
+		}
+
+		dataField += fmt.Sprintf("@%s@%s@%s@%s", sPair, utils.StringArg("swapTokensFixedInput"), utils.StringArg(step.TokenOut), utils.BigIntArg(step.MinAmountOut))
+	}
+	gasLimit := swapGasLimit * uint64(len(steps))
+	hash, err := xex.netMan.SendTransaction(pk, utils.DexRouterSC, 0, gasLimit, dataField, utils.AutoNonce)
+	if err != nil {
+		return "", err
+	}
+
+	err = xex.netMan.GetTxResult(hash)
+	if err != nil {
+		return hash, err
+	}
+
+	return hash, nil
+}
@@ -20,6 +20,10 @@ func (xex *XExchange) startTasks() {
 			startTime := time.Now().UnixNano()
 
 			xex.refreshPairs()
+			if !initialized {
+				// the farms are matched with the loaded pairs
+				xex.startFarmsTask()
+			}
 
 			endTime := time.Now().UnixNano()
 			waitTime := xex.refreshInterval - time.Duration(endTime-startTime)
@@ -31,6 +35,28 @@ func (xex *XExchange) startTasks() {
 	}()
 }
 
+// farms discovery scans all MetaESDT collections and queries their owners, so it runs in its own, slower loop
+func (xex *XExchange) startFarmsTask() {
+	// farms are discovered using the indexer
+	if xex.netMan.GetIndexAddress() == "" {
+		return
+	}
+
+	go func() {
+		for {
+			startTime := time.Now().UnixNano()
+
+			xex.refreshFarms()
+
+			endTime := time.Now().UnixNano()
+			waitTime := xex.farmsRefreshInterval - time.Duration(endTime-startTime)
+			if waitTime > 0 {
+				time.Sleep(waitTime)
+			}
+		}
+	}()
+}
+
 func (xex *XExchange) refreshPairs() {
 	stateKey := hex.EncodeToString([]byte("state"))
 	bNewState, err := xex.routerScAccount.GetAccountKey(stateKey)
@@ -72,3 +98,24 @@ func (xex *XExchange) refreshPairs() {
 	xex.cachedPairs = newPairs
 	xex.cachedPairsMut.Unlock()
 }
+
+func (xex *XExchange) refreshFarms() {
+	candidates, err := xex.getFarmCandidates()
+	if err != nil {
+		log.Error("get farm candidates", "error", err, "function", "refreshFarms")
+		return
+	}
+
+	farms, err := xex.getFarmsFromCandidates(candidates)
+	if err != nil {
+		log.Error("get farms", "error", err, "function", "refreshFarms")
+	} else {
+		xex.cachedFarmsMut.Lock()
+		xex.cachedFarms = farms
+		xex.cachedFarmsMut.Unlock()
+	}
+
+	xex.cachedMetastakingsMut.Lock()
+	xex.cachedMetastakings = xex.getMetastakingsFromCandidates(candidates)
+	xex.cachedMetastakingsMut.Unlock()
+}
@@ -28,15 +28,24 @@ type XExchange struct {
 	mxTokens        *tokens.Tokens
 	refreshInterval time.Duration
 
+	farmsRefreshInterval time.Duration
+
 	dexState       bool
 	cachedPairs    map[string]*DexPair
 	cachedPairsMut sync.Mutex
 
+	cachedFarms           map[string]*Farm
+	cachedFarmsMut        sync.Mutex
+	cachedMetastakings    map[string]*Metastaking
+	cachedMetastakingsMut sync.Mutex
+
 	newPairCallback          NewPairCallbackFunc
 	pairStateChangedCallback PairStateChangedCallbackFunc
 	dexStateChangedCallback  DexStateChangedCallbackFunc
 }
 
+const defaultFarmsRefreshInterval = 10 * time.Minute
+
 var log = logger.GetOrCreate("xexchange")
 
 func NewXExchange(netMan *network.NetworkManager, refreshInterval time.Duration) (*XExchange, error) {
@@ -56,7 +65,11 @@ func NewXExchange(netMan *network.NetworkManager, refreshInterval time.Duration)
 		mxTokens:        mxTokens,
 		refreshInterval: refreshInterval,
 
-		cachedPairs: make(map[string]*DexPair),
+		farmsRefreshInterval: defaultFarmsRefreshInterval,
+
+		cachedPairs:        make(map[string]*DexPair),
+		cachedFarms:        make(map[string]*Farm),
+		cachedMetastakings: make(map[string]*Metastaking),
 
 		newPairCallback:          nil,
 		pairStateChangedCallback: nil,
@@ -67,6 +80,11 @@ func NewXExchange(netMan *network.NetworkManager, refreshInterval time.Duration)
 	return xex, nil
 }
 
+// farms and metastaking contracts are discovered and refreshed at this interval, separately from the pairs
+func (xex *XExchange) SetFarmsRefreshInterval(interval time.Duration) {
+	xex.farmsRefreshInterval = interval
+}
+
 func (xex *XExchange) SetNewPairCallback(f NewPairCallbackFunc) {
 	xex.newPairCallback = f
 }
@@ -201,6 +219,16 @@ func (xex *XExchange) getPairData(ticker1 string, ticker2 string, contractAddres
 		return nil, err
 	}
 
+	lpToken, err := utils.GetKey("lpTokenIdentifier", keys)
+	if err == nil {
+		result.LpToken = string(lpToken)
+	}
+
+	result.LpSupply, err = utils.GetBigIntKey("lp_token_supply", keys)
+	if err != nil {
+		result.LpSupply = big.NewInt(0)
+	}
+
 	return result, nil
 }
 
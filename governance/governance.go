@@ -0,0 +1,399 @@
+package governance
+
+import (
+	"encoding/hex"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/stakingagency/sa-mx-sdk-go/accounts"
+	"github.com/stakingagency/sa-mx-sdk-go/data"
+	"github.com/stakingagency/sa-mx-sdk-go/network"
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+const (
+	StatusNone           = "none"
+	StatusPending        = "pending"
+	StatusActive         = "active"
+	StatusDefeated       = "defeated"
+	StatusSucceeded      = "succeeded"
+	StatusQueued         = "queued"
+	StatusWaitingForFees = "waitingForFees"
+	StatusExecuted       = "executed"
+	StatusCanceled       = "canceled"
+	StatusClosed         = "closed"
+
+	VoteUp       = byte(0)
+	VoteDown     = byte(1)
+	VoteDownVeto = byte(2)
+	VoteAbstain  = byte(3)
+
+	// governance.abi.json, voting with tokens (upvote, downvote, redeem)
+	VersionV1 = byte(1)
+	// governance-v2.abi.json, energy based voting
+	VersionV2 = byte(2)
+
+	energyDecimals = 18
+)
+
+var (
+	statuses   = []string{StatusNone, StatusPending, StatusActive, StatusDefeated, StatusSucceeded, StatusQueued, StatusWaitingForFees}
+	statusesV1 = []string{StatusPending, StatusActive, StatusDefeated, StatusSucceeded, StatusExecuted}
+)
+
+type (
+	NewProposalCallbackFunc           func(proposal *data.Proposal)
+	ProposalVotedCallbackFunc         func(proposalID uint32, voteType byte, votes float64)
+	ProposalStatusChangedCallbackFunc func(proposalID uint32, oldStatus string, newStatus string)
+)
+
+type Governance struct {
+	netMan          *network.NetworkManager
+	contractAddress string
+	version         byte
+	refreshInterval time.Duration
+
+	cachedProposals    map[uint32]*data.Proposal
+	cachedProposalsMut sync.Mutex
+
+	newProposalCallback           NewProposalCallbackFunc
+	proposalVotedCallback         ProposalVotedCallbackFunc
+	proposalStatusChangedCallback ProposalStatusChangedCallbackFunc
+}
+
+var log = logger.GetOrCreate("governance")
+
+func NewGovernance(netMan *network.NetworkManager, contractAddress string, version byte, refreshInterval time.Duration) (*Governance, error) {
+	if version != VersionV1 && version != VersionV2 {
+		return nil, utils.ErrInvalidVersion
+	}
+
+	gov := &Governance{
+		netMan:          netMan,
+		contractAddress: contractAddress,
+		version:         version,
+		refreshInterval: refreshInterval,
+
+		cachedProposals: make(map[uint32]*data.Proposal),
+
+		newProposalCallback:           nil,
+		proposalVotedCallback:         nil,
+		proposalStatusChangedCallback: nil,
+	}
+	gov.startTasks()
+
+	return gov, nil
+}
+
+func (gov *Governance) SetNewProposalCallback(f NewProposalCallbackFunc) {
+	gov.newProposalCallback = f
+}
+
+func (gov *Governance) SetProposalVotedCallback(f ProposalVotedCallbackFunc) {
+	gov.proposalVotedCallback = f
+}
+
+func (gov *Governance) SetProposalStatusChangedCallback(f ProposalStatusChangedCallbackFunc) {
+	gov.proposalStatusChangedCallback = f
+}
+
+func (gov *Governance) GetVersion() byte {
+	return gov.version
+}
+
+func (gov *Governance) GetConfig() (*data.GovernanceConfig, error) {
+	if gov.version == VersionV1 {
+		return gov.getConfigV1()
+	}
+
+	cfg := &data.GovernanceConfig{
+		ContractAddress: gov.contractAddress,
+		Version:         gov.version,
+	}
+
+	var err error
+	cfg.EnergyFactoryAddress, err = gov.netMan.QueryScAddressResult(gov.contractAddress, "getEnergyFactoryAddress", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := gov.netMan.QuerySC(gov.contractAddress, "getFeeTokenId", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res.Data.ReturnData) > 0 {
+		cfg.FeeToken = string(res.Data.ReturnData[0])
+	}
+
+	floats := map[string]*float64{
+		"getMinEnergyForPropose": &cfg.MinEnergyForPropose,
+		"getMinFeeForPropose":    &cfg.MinFeeForPropose,
+		"getQuorum":              &cfg.Quorum,
+	}
+	for funcName, value := range floats {
+		iValue, err := gov.netMan.QueryScIntResult(gov.contractAddress, funcName, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		*value = utils.Denominate(iValue, energyDecimals)
+	}
+
+	uints := map[string]*uint64{
+		"getVotingDelayInBlocks":             &cfg.VotingDelayInBlocks,
+		"getVotingPeriodInBlocks":            &cfg.VotingPeriodInBlocks,
+		"getLockTimeAfterVotingEndsInBlocks": &cfg.LockTimeAfterVotingEndsInBlocks,
+	}
+	for funcName, value := range uints {
+		iValue, err := gov.netMan.QueryScIntResult(gov.contractAddress, funcName, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		*value = iValue.Uint64()
+	}
+
+	return cfg, nil
+}
+
+// the voting power is the user's energy. In v1 the votes are weighted by the tokens paid when voting
+func (gov *Governance) GetVotingPower(address string) (float64, error) {
+	if gov.version == VersionV1 {
+		return 0, utils.ErrNotSupported
+	}
+
+	sAddress, err := utils.AddressArg(address)
+	if err != nil {
+		return 0, err
+	}
+
+	energyFactory, err := gov.netMan.QueryScAddressResult(gov.contractAddress, "getEnergyFactoryAddress", nil)
+	if err != nil {
+		return 0, err
+	}
+
+	energy, err := gov.netMan.QueryScIntResult(energyFactory, "getEnergyAmountForUser", []string{sAddress})
+	if err != nil {
+		return 0, err
+	}
+
+	return utils.Denominate(energy, energyDecimals), nil
+}
+
+func (gov *Governance) GetProposalsCount() (uint32, error) {
+	if gov.version == VersionV1 {
+		count, err := gov.netMan.QueryScIntResult(gov.contractAddress, "getProposalIdCounter", nil)
+		if err != nil {
+			return 0, err
+		}
+
+		return uint32(count.Uint64()), nil
+	}
+
+	account, err := accounts.NewAccount(gov.contractAddress, gov.netMan, utils.NoRefresh)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := account.GetAccountKey(hex.EncodeToString([]byte("proposals.len")))
+	if err != nil {
+		return 0, err
+	}
+
+	return uint32(big.NewInt(0).SetBytes(count).Uint64()), nil
+}
+
+func (gov *Governance) GetProposals() (map[uint32]*data.Proposal, error) {
+	count, err := gov.GetProposalsCount()
+	if err != nil {
+		return nil, err
+	}
+
+	quorum, err := gov.netMan.QueryScIntResult(gov.contractAddress, "getQuorum", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// v2 proposals are stored in a 1 based vec mapper, v1 ids start from 0 (the counter holds the next id)
+	first := uint32(1)
+	if gov.version == VersionV1 {
+		first = 0
+	}
+
+	res := make(map[uint32]*data.Proposal)
+	for id := first; id < first+count; id++ {
+		proposal, err := gov.getProposal(id, quorum)
+		if err != nil {
+			log.Debug("get proposal", "error", err, "id", id, "function", "GetProposals")
+			continue
+		}
+
+		res[id] = proposal
+	}
+
+	return res, nil
+}
+
+func (gov *Governance) GetCachedProposals() (map[uint32]*data.Proposal, error) {
+	if gov.refreshInterval == utils.NoRefresh {
+		return nil, utils.ErrRefreshIntervalNotSet
+	}
+
+	res := make(map[uint32]*data.Proposal)
+	gov.cachedProposalsMut.Lock()
+	for k, v := range gov.cachedProposals {
+		res[k] = v
+	}
+	gov.cachedProposalsMut.Unlock()
+
+	return res, nil
+}
+
+func (gov *Governance) GetProposal(id uint32) (*data.Proposal, error) {
+	quorum, err := gov.netMan.QueryScIntResult(gov.contractAddress, "getQuorum", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return gov.getProposal(id, quorum)
+}
+
+func (gov *Governance) getProposal(id uint32, quorum *big.Int) (*data.Proposal, error) {
+	if gov.version == VersionV1 {
+		return gov.getProposalV1(id, quorum)
+	}
+
+	args := []string{utils.Uint64Arg(uint64(id))}
+	proposal := &data.Proposal{
+		ID:      id,
+		Actions: make([]*data.ProposalAction, 0),
+		Quorum:  utils.Denominate(quorum, energyDecimals),
+	}
+
+	status, err := gov.netMan.QueryScIntResult(gov.contractAddress, "getProposalStatus", args)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal.Status = StatusNone
+	if status.Uint64() < uint64(len(statuses)) {
+		proposal.Status = statuses[status.Uint64()]
+	}
+
+	// executed and canceled proposals are removed from the contract's storage
+	if proposal.Status == StatusNone {
+		proposal.Status = StatusClosed
+		return proposal, nil
+	}
+
+	proposal.Proposer, err = gov.netMan.QueryScAddressResult(gov.contractAddress, "getProposer", args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := gov.netMan.QuerySC(gov.contractAddress, "getProposalDescription", args)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res.Data.ReturnData) > 0 {
+		proposal.Description = string(res.Data.ReturnData[0])
+	}
+
+	res, err = gov.netMan.QuerySC(gov.contractAddress, "getProposalActions", args)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res.Data.ReturnData) > 0 {
+		proposal.Actions, err = parseActions(res.Data.ReturnData[0])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res, err = gov.netMan.QuerySC(gov.contractAddress, "getProposalVotes", args)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res.Data.ReturnData) > 0 {
+		votes := make([]*big.Int, 4)
+		idx := 0
+		for i := range votes {
+			var ok bool
+			votes[i], idx, ok = utils.ParseBigInt(res.Data.ReturnData[0], idx)
+			if !ok {
+				return nil, utils.ErrInvalidResponse
+			}
+		}
+
+		proposal.UpVotes = utils.Denominate(votes[0], energyDecimals)
+		proposal.DownVotes = utils.Denominate(votes[1], energyDecimals)
+		proposal.DownVetoVotes = utils.Denominate(votes[2], energyDecimals)
+		proposal.AbstainVotes = utils.Denominate(votes[3], energyDecimals)
+		total := big.NewInt(0)
+		for _, vote := range votes {
+			total.Add(total, vote)
+		}
+		proposal.TotalVotes = utils.Denominate(total, energyDecimals)
+		proposal.QuorumReached = total.Cmp(quorum) >= 0
+	}
+
+	return proposal, nil
+}
+
+func parseActions(bytes []byte) ([]*data.ProposalAction, error) {
+	conv, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	actions := make([]*data.ProposalAction, 0)
+	idx := 0
+	for idx < len(bytes) {
+		action := &data.ProposalAction{
+			Arguments: make([][]byte, 0),
+		}
+		var dest []byte
+		var ok bool
+		action.GasLimit, idx, ok = utils.ParseUint64(bytes, idx)
+		allOk := ok
+		dest, idx, ok = utils.ParsePubkey(bytes, idx)
+		allOk = allOk && ok
+		action.FunctionName, idx, ok = utils.ParseString(bytes, idx)
+		allOk = allOk && ok
+		action.Arguments, idx, ok = parseBuffers(bytes, idx)
+		allOk = allOk && ok
+		if !allOk {
+			return nil, utils.ErrInvalidResponse
+		}
+
+		action.DestAddress, _ = conv.Encode(dest)
+		actions = append(actions, action)
+	}
+
+	return actions, nil
+}
+
+// parses a list of buffers, each prefixed by its 4 bytes length
+func parseBuffers(bytes []byte, index int) ([][]byte, int, bool) {
+	count, index, ok := utils.ParseUint32(bytes, index)
+	if !ok {
+		return nil, 0, false
+	}
+
+	res := make([][]byte, 0, count)
+	for i := uint32(0); i < count; i++ {
+		var length uint32
+		length, index, ok = utils.ParseUint32(bytes, index)
+		if !ok || index+int(length) > len(bytes) {
+			return nil, 0, false
+		}
+
+		res = append(res, bytes[index:index+int(length)])
+		index += int(length)
+	}
+
+	return res, index, true
+}
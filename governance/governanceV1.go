@@ -0,0 +1,355 @@
+package governance
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/stakingagency/sa-mx-sdk-go/accounts"
+	"github.com/stakingagency/sa-mx-sdk-go/data"
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+// the v1 contract weights the proposals and votes by the MEX value of the paid governance tokens
+const weightDecimals = 18
+
+func (gov *Governance) getConfigV1() (*data.GovernanceConfig, error) {
+	cfg := &data.GovernanceConfig{
+		ContractAddress:  gov.contractAddress,
+		Version:          gov.version,
+		GovernanceTokens: make([]string, 0),
+	}
+
+	var err error
+	cfg.VoteNftToken, err = gov.queryString("getVoteNFTId")
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.MexToken, err = gov.queryString("getMexTokenId")
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := gov.netMan.QuerySC(gov.contractAddress, "getGovernanceTokenId", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, token := range res.Data.ReturnData {
+		cfg.GovernanceTokens = append(cfg.GovernanceTokens, string(token))
+	}
+
+	floats := map[string]*float64{
+		"getMinWeightForProposal": &cfg.MinWeightForPropose,
+		"getQuorum":               &cfg.Quorum,
+	}
+	for funcName, value := range floats {
+		iValue, err := gov.netMan.QueryScIntResult(gov.contractAddress, funcName, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		*value = utils.Denominate(iValue, weightDecimals)
+	}
+
+	uints := map[string]*uint64{
+		"getVotingDelayInBlocks":  &cfg.VotingDelayInBlocks,
+		"getVotingPeriodInBlocks": &cfg.VotingPeriodInBlocks,
+	}
+	for funcName, value := range uints {
+		iValue, err := gov.netMan.QueryScIntResult(gov.contractAddress, funcName, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		*value = iValue.Uint64()
+	}
+
+	return cfg, nil
+}
+
+// v1 proposals are never removed from the contract's storage
+func (gov *Governance) getProposalV1(id uint32, quorum *big.Int) (*data.Proposal, error) {
+	args := []string{utils.Uint64Arg(uint64(id))}
+	res, err := gov.netMan.QuerySC(gov.contractAddress, "getProposal", args)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res.Data.ReturnData) == 0 || len(res.Data.ReturnData[0]) == 0 {
+		return nil, utils.ErrInvalidResponse
+	}
+
+	proposal, upVotes, downVotes, err := parseProposalV1(res.Data.ReturnData[0])
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := gov.netMan.QueryScIntResult(gov.contractAddress, "getProposalStatus", args)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal.Status = StatusNone
+	if status.Uint64() < uint64(len(statusesV1)) {
+		proposal.Status = statusesV1[status.Uint64()]
+	}
+
+	total := big.NewInt(0).Add(upVotes, downVotes)
+	proposal.Quorum = utils.Denominate(quorum, weightDecimals)
+	proposal.TotalVotes = utils.Denominate(total, weightDecimals)
+	proposal.QuorumReached = total.Cmp(quorum) >= 0
+
+	return proposal, nil
+}
+
+// Proposal { id, creation_block, proposer, description, was_executed, actions, num_upvotes, num_downvotes }
+func parseProposalV1(bytes []byte) (*data.Proposal, *big.Int, *big.Int, error) {
+	conv, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	proposal := &data.Proposal{
+		Actions: make([]*data.ProposalAction, 0),
+	}
+	id, idx, ok := utils.ParseUint64(bytes, 0)
+	allOk := ok
+	_, idx, ok = utils.ParseUint64(bytes, idx)
+	allOk = allOk && ok
+	proposer, idx, ok := utils.ParsePubkey(bytes, idx)
+	allOk = allOk && ok
+	proposal.Description, idx, ok = utils.ParseString(bytes, idx)
+	allOk = allOk && ok
+	_, idx, ok = utils.ParseBool(bytes, idx)
+	allOk = allOk && ok
+	count, idx, ok := utils.ParseUint32(bytes, idx)
+	allOk = allOk && ok
+	if !allOk {
+		return nil, nil, nil, utils.ErrInvalidResponse
+	}
+
+	for i := uint32(0); i < count; i++ {
+		// Action { gas_limit, dest_address, payments, endpoint_name, arguments }
+		action := &data.ProposalAction{}
+		var dest []byte
+		action.GasLimit, idx, ok = utils.ParseUint64(bytes, idx)
+		allOk = ok
+		dest, idx, ok = utils.ParsePubkey(bytes, idx)
+		allOk = allOk && ok
+		_, idx, ok = parseBuffers(bytes, idx)
+		allOk = allOk && ok
+		action.FunctionName, idx, ok = utils.ParseString(bytes, idx)
+		allOk = allOk && ok
+		action.Arguments, idx, ok = parseBuffers(bytes, idx)
+		allOk = allOk && ok
+		if !allOk {
+			return nil, nil, nil, utils.ErrInvalidResponse
+		}
+
+		action.DestAddress, _ = conv.Encode(dest)
+		proposal.Actions = append(proposal.Actions, action)
+	}
+
+	upVotes, idx, ok := utils.ParseBigInt(bytes, idx)
+	allOk = ok
+	downVotes, _, ok := utils.ParseBigInt(bytes, idx)
+	allOk = allOk && ok
+	if !allOk {
+		return nil, nil, nil, utils.ErrInvalidResponse
+	}
+
+	proposal.ID = uint32(id)
+	proposal.Proposer, _ = conv.Encode(proposer)
+	proposal.UpVotes = utils.Denominate(upVotes, weightDecimals)
+	proposal.DownVotes = utils.Denominate(downVotes, weightDecimals)
+
+	return proposal, upVotes, downVotes, nil
+}
+
+// the user's votes that were not redeemed yet
+func (gov *Governance) GetUserVotes(address string) ([]*data.GovernanceVote, error) {
+	if gov.version != VersionV1 {
+		return nil, utils.ErrNotSupported
+	}
+
+	voteNft, err := gov.queryString("getVoteNFTId")
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := accounts.NewAccount(address, gov.netMan, utils.NoRefresh)
+	if err != nil {
+		return nil, err
+	}
+
+	nfts, err := account.GetNFTs()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*data.GovernanceVote, 0)
+	for _, nft := range nfts {
+		if nft.Collection != voteNft {
+			continue
+		}
+
+		vote, err := parseVoteAttributes(nft.Attributes)
+		if err != nil {
+			log.Debug("parse vote attributes", "error", err, "identifier", nft.Identifier, "function", "GetUserVotes")
+			continue
+		}
+
+		vote.Nonce = nft.Nonce
+		res = append(res, vote)
+	}
+
+	return res, nil
+}
+
+// VoteNFTAttributes { proposal_id, vote_type, vote_weight, voter, payment { token_identifier, token_nonce, amount } }
+func parseVoteAttributes(bytes []byte) (*data.GovernanceVote, error) {
+	conv, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	vote := &data.GovernanceVote{}
+	proposalID, idx, ok := utils.ParseUint64(bytes, 0)
+	allOk := ok
+	vote.VoteType, idx, ok = utils.ParseByte(bytes, idx)
+	allOk = allOk && ok
+	weight, idx, ok := utils.ParseBigInt(bytes, idx)
+	allOk = allOk && ok
+	voter, idx, ok := utils.ParsePubkey(bytes, idx)
+	allOk = allOk && ok
+	vote.PaymentToken, idx, ok = utils.ParseString(bytes, idx)
+	allOk = allOk && ok
+	vote.PaymentNonce, idx, ok = utils.ParseUint64(bytes, idx)
+	allOk = allOk && ok
+	vote.PaymentAmount, _, ok = utils.ParseBigInt(bytes, idx)
+	allOk = allOk && ok
+	if !allOk {
+		return nil, utils.ErrInvalidResponse
+	}
+
+	vote.ProposalID = uint32(proposalID)
+	vote.Weight = utils.Denominate(weight, weightDecimals)
+	vote.Voter, _ = conv.Encode(voter)
+
+	return vote, nil
+}
+
+// the paid governance tokens give the proposal's weight. Returns the new proposal's id
+func (gov *Governance) ProposeWithTokens(pk []byte, description string, actions []*data.ProposalAction, token *data.ESDT, amount float64) (uint32, error) {
+	if gov.version != VersionV1 {
+		return 0, utils.ErrNotSupported
+	}
+
+	// ProposalCreationArgs { description, actions }
+	args := utils.EncodeString(description)
+	count := make([]byte, 4)
+	binary.BigEndian.PutUint32(count, uint32(len(actions)))
+	args = append(args, count...)
+	gasLimit := proposeGasLimit
+	conv, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	for _, action := range actions {
+		dest, err := conv.Decode(action.DestAddress)
+		if err != nil {
+			return 0, err
+		}
+
+		gas := make([]byte, 8)
+		binary.BigEndian.PutUint64(gas, action.GasLimit)
+		args = append(append(args, gas...), dest...)
+		// the actions don't carry payments
+		args = append(args, 0, 0, 0, 0)
+		args = append(args, utils.EncodeString(action.FunctionName)...)
+		binary.BigEndian.PutUint32(count, uint32(len(action.Arguments)))
+		args = append(args, count...)
+		for _, arg := range action.Arguments {
+			args = append(args, utils.EncodeString(string(arg))...)
+		}
+		gasLimit += action.GasLimit
+	}
+
+	hash, err := gov.netMan.SendEsdtTransaction(pk, gov.contractAddress, amount, gasLimit, token, "propose", []string{hex.EncodeToString(args)}, utils.AutoNonce)
+	if err != nil {
+		return 0, err
+	}
+
+	err = gov.netMan.GetTxResult(hash)
+	if err != nil {
+		return 0, err
+	}
+
+	events, err := gov.netMan.GetTxEvents(hash, "propose")
+	if err != nil {
+		return 0, err
+	}
+
+	// the event's topics are [identifier, caller, proposal, payment, weight, timestamp, epoch]
+	for _, event := range events {
+		if len(event.Topics) < 3 || utils.Base64Decode(event.Topics[0]) != "propose" {
+			continue
+		}
+
+		id, _, ok := utils.ParseUint64([]byte(utils.Base64Decode(event.Topics[2])), 0)
+		if !ok {
+			return 0, utils.ErrInvalidResponse
+		}
+
+		return uint32(id), nil
+	}
+
+	return 0, utils.ErrEventNotFound
+}
+
+// the paid governance tokens give the vote's weight. They are returned by Redeem, in exchange of the received vote NFT
+func (gov *Governance) Upvote(pk []byte, proposalID uint32, token *data.ESDT, amount float64) error {
+	return gov.voteWithTokens(pk, "upvote", proposalID, token, amount)
+}
+
+func (gov *Governance) Downvote(pk []byte, proposalID uint32, token *data.ESDT, amount float64) error {
+	return gov.voteWithTokens(pk, "downvote", proposalID, token, amount)
+}
+
+// the vote NFT can be redeemed after the proposal's voting period ended
+func (gov *Governance) Redeem(pk []byte, voteNonce uint64) error {
+	if gov.version != VersionV1 {
+		return utils.ErrNotSupported
+	}
+
+	voteNft, err := gov.queryString("getVoteNFTId")
+	if err != nil {
+		return err
+	}
+
+	hash, err := gov.netMan.SendEsdtNftTransaction(pk, gov.contractAddress, voteNft, voteNonce, big.NewInt(1), actionGasLimit, "redeem", nil, utils.AutoNonce)
+	if err != nil {
+		return err
+	}
+
+	return gov.netMan.GetTxResult(hash)
+}
+
+func (gov *Governance) voteWithTokens(pk []byte, function string, proposalID uint32, token *data.ESDT, amount float64) error {
+	if gov.version != VersionV1 {
+		return utils.ErrNotSupported
+	}
+
+	args := []string{utils.Uint64Arg(uint64(proposalID))}
+	hash, err := gov.netMan.SendEsdtTransaction(pk, gov.contractAddress, amount, voteGasLimit, token, function, args, utils.AutoNonce)
+	if err != nil {
+		return err
+	}
+
+	return gov.netMan.GetTxResult(hash)
+}
+
+func (gov *Governance) queryString(funcName string) (string, error) {
+	res, err := gov.netMan.QuerySC(gov.contractAddress, funcName, nil)
+	if err != nil {
+		return "", err
+	}
+
+	if len(res.Data.ReturnData) == 0 {
+		return "", utils.ErrInvalidResponse
+	}
+
+	return string(res.Data.ReturnData[0]), nil
+}
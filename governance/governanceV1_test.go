@@ -0,0 +1,54 @@
+package governance
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+// synthetic nested encodings, following the governance.abi.json types
+
+// proposal 3 by 0x01.., "test", one setFee action to 0x02.. with the argument 0x0064, 1 upvote and no downvotes
+const fixtureProposalV1 = "000000000000000300000000000000640101010101010101010101010101010101010101010101010101010101010101000000047465737400000000010000000001312d000202020202020202020202020202020202020202020202020202020202020202000000000000000673657446656500000001000000020064000000080de0b6b3a764000000000000"
+
+// a downvote with weight 1 on proposal 3 by 0x03.., paid with 1 MEX-455c57
+const fixtureVoteAttributes = "000000000000000301000000080de0b6b3a764000003030303030303030303030303030303030303030303030303030303030303030000000a4d45582d3435356335370000000000000000000000080de0b6b3a7640000"
+
+func TestParseProposalV1(t *testing.T) {
+	raw, _ := hex.DecodeString(fixtureProposalV1)
+	proposal, upVotes, downVotes, err := parseProposalV1(raw)
+	if err != nil {
+		t.Fatalf("parse proposal: %v", err)
+	}
+
+	if proposal.ID != 3 || proposal.Description != "test" || proposal.UpVotes != 1 || proposal.DownVotes != 0 {
+		t.Errorf("unexpected proposal %+v", proposal)
+	}
+	if upVotes.String() != "1000000000000000000" || downVotes.Sign() != 0 {
+		t.Errorf("unexpected votes %v %v", upVotes, downVotes)
+	}
+	if len(proposal.Actions) != 1 {
+		t.Fatalf("expected 1 action, got %v", len(proposal.Actions))
+	}
+
+	action := proposal.Actions[0]
+	if action.GasLimit != 20000000 || action.FunctionName != "setFee" || len(action.Arguments) != 1 || !bytes.Equal(action.Arguments[0], []byte{0, 100}) {
+		t.Errorf("unexpected action %+v", action)
+	}
+
+	if _, _, _, err = parseProposalV1(raw[:len(raw)-5]); err == nil {
+		t.Error("expected an error for a truncated proposal")
+	}
+}
+
+func TestParseVoteAttributes(t *testing.T) {
+	raw, _ := hex.DecodeString(fixtureVoteAttributes)
+	vote, err := parseVoteAttributes(raw)
+	if err != nil {
+		t.Fatalf("parse vote attributes: %v", err)
+	}
+
+	if vote.ProposalID != 3 || vote.VoteType != VoteDown || vote.Weight != 1 || vote.PaymentToken != "MEX-455c57" || vote.PaymentNonce != 0 || vote.PaymentAmount.String() != "1000000000000000000" {
+		t.Errorf("unexpected vote %+v", vote)
+	}
+}
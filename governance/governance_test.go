@@ -0,0 +1,28 @@
+package governance
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+// synthetic getProposalActions return data: setFee(0x0064) to 0x02.. and stop() to 0x04..
+const fixtureActions = "0000000001312d00020202020202020202020202020202020202020202020202020202020202020200000006736574466565000000010000000200640000000001c9c38004040404040404040404040404040404040404040404040404040404040404040000000473746f7000000000"
+
+func TestParseActions(t *testing.T) {
+	raw, _ := hex.DecodeString(fixtureActions)
+	actions, err := parseActions(raw)
+	if err != nil {
+		t.Fatalf("parse actions: %v", err)
+	}
+
+	if len(actions) != 2 {
+		t.Fatalf("expected 2 actions, got %v", len(actions))
+	}
+	if actions[0].GasLimit != 20000000 || actions[0].FunctionName != "setFee" || len(actions[0].Arguments) != 1 || !bytes.Equal(actions[0].Arguments[0], []byte{0, 100}) {
+		t.Errorf("unexpected action %+v", actions[0])
+	}
+	if actions[1].GasLimit != 30000000 || actions[1].FunctionName != "stop" || len(actions[1].Arguments) != 0 || actions[0].DestAddress == actions[1].DestAddress {
+		t.Errorf("unexpected action %+v", actions[1])
+	}
+}
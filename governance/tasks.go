@@ -0,0 +1,95 @@
+package governance
+
+import (
+	"time"
+
+	"github.com/stakingagency/sa-mx-sdk-go/data"
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+var initialized = false
+
+func (gov *Governance) startTasks() {
+	if gov.refreshInterval == utils.NoRefresh {
+		return
+	}
+
+	go func() {
+		for {
+			startTime := time.Now().UnixNano()
+
+			gov.refreshProposals()
+
+			endTime := time.Now().UnixNano()
+			waitTime := gov.refreshInterval - time.Duration(endTime-startTime)
+			if waitTime > 0 {
+				time.Sleep(waitTime)
+			}
+			initialized = true
+		}
+	}()
+}
+
+func (gov *Governance) refreshProposals() {
+	newProposals, err := gov.GetProposals()
+	if err != nil {
+		log.Error("get proposals", "error", err, "function", "refreshProposals")
+		return
+	}
+
+	gov.cachedProposalsMut.Lock()
+	oldProposals := gov.cachedProposals
+	gov.cachedProposalsMut.Unlock()
+
+	for id, newProposal := range newProposals {
+		oldProposal := oldProposals[id]
+		if oldProposal == nil {
+			if initialized && gov.newProposalCallback != nil {
+				gov.newProposalCallback(newProposal)
+			}
+			continue
+		}
+
+		// closed proposals keep their details from the last refresh. Only queued proposals can be executed
+		if newProposal.Status == StatusClosed && oldProposal.Status != StatusClosed {
+			closedProposal := *oldProposal
+			switch oldProposal.Status {
+			case StatusExecuted, StatusCanceled:
+			case StatusQueued:
+				closedProposal.Status = StatusExecuted
+			default:
+				closedProposal.Status = StatusCanceled
+			}
+			newProposals[id] = &closedProposal
+			newProposal = &closedProposal
+		}
+
+		if newProposal.Status != oldProposal.Status && gov.proposalStatusChangedCallback != nil {
+			gov.proposalStatusChangedCallback(id, oldProposal.Status, newProposal.Status)
+		}
+
+		gov.checkVotes(oldProposal, newProposal)
+	}
+
+	gov.cachedProposalsMut.Lock()
+	gov.cachedProposals = newProposals
+	gov.cachedProposalsMut.Unlock()
+}
+
+func (gov *Governance) checkVotes(oldProposal *data.Proposal, newProposal *data.Proposal) {
+	if gov.proposalVotedCallback == nil {
+		return
+	}
+
+	votes := map[byte][2]float64{
+		VoteUp:       {oldProposal.UpVotes, newProposal.UpVotes},
+		VoteDown:     {oldProposal.DownVotes, newProposal.DownVotes},
+		VoteDownVeto: {oldProposal.DownVetoVotes, newProposal.DownVetoVotes},
+		VoteAbstain:  {oldProposal.AbstainVotes, newProposal.AbstainVotes},
+	}
+	for voteType, vote := range votes {
+		if vote[1] > vote[0] {
+			gov.proposalVotedCallback(newProposal.ID, voteType, vote[1]-vote[0])
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package governance
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/stakingagency/sa-mx-sdk-go/data"
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+const (
+	proposeGasLimit = uint64(50000000)
+	voteGasLimit    = uint64(20000000)
+	actionGasLimit  = uint64(20000000)
+)
+
+// v2 only. The v1 proposals are created with ProposeWithTokens
+func (gov *Governance) Propose(pk []byte, description string, actions []*data.ProposalAction) (uint32, error) {
+	if gov.version != VersionV2 {
+		return 0, utils.ErrNotSupported
+	}
+
+	dataField := "propose@" + utils.StringArg(description)
+	gasLimit := proposeGasLimit
+	for _, action := range actions {
+		dest, err := utils.AddressArg(action.DestAddress)
+		if err != nil {
+			return 0, err
+		}
+
+		args := make([]byte, 0)
+		for _, arg := range action.Arguments {
+			argLen := make([]byte, 4)
+			binary.BigEndian.PutUint32(argLen, uint32(len(arg)))
+			args = append(append(args, argLen...), arg...)
+		}
+		dataField += fmt.Sprintf("@%s@%s@%s@%s", utils.Uint64Arg(action.GasLimit), dest, utils.StringArg(action.FunctionName), hex.EncodeToString(args))
+		gasLimit += action.GasLimit
+	}
+
+	hash, err := gov.netMan.SendTransaction(pk, gov.contractAddress, 0, gasLimit, dataField, utils.AutoNonce)
+	if err != nil {
+		return 0, err
+	}
+
+	err = gov.netMan.GetTxResult(hash)
+	if err != nil {
+		return 0, err
+	}
+
+	events, err := gov.netMan.GetTxEvents(hash, "propose")
+	if err != nil {
+		return 0, err
+	}
+
+	for _, event := range events {
+		if len(event.Topics) < 2 || utils.Base64Decode(event.Topics[0]) != "proposalCreated" {
+			continue
+		}
+
+		id := big.NewInt(0).SetBytes([]byte(utils.Base64Decode(event.Topics[1])))
+
+		return uint32(id.Uint64()), nil
+	}
+
+	return 0, utils.ErrEventNotFound
+}
+
+// v2 only. The v1 votes are cast with Upvote and Downvote
+func (gov *Governance) Vote(pk []byte, proposalID uint32, voteType byte) error {
+	if gov.version != VersionV2 {
+		return utils.ErrNotSupported
+	}
+
+	if voteType > VoteAbstain {
+		return utils.ErrInvalidVoteType
+	}
+
+	dataField := fmt.Sprintf("vote@%s@%s", utils.Uint64Arg(uint64(proposalID)), utils.Uint64Arg(uint64(voteType)))
+
+	return gov.sendTransaction(pk, voteGasLimit, dataField)
+}
+
+func (gov *Governance) DepositTokensForProposal(pk []byte, proposalID uint32, token *data.ESDT, amount float64) error {
+	if gov.version != VersionV2 {
+		return utils.ErrNotSupported
+	}
+
+	args := []string{utils.Uint64Arg(uint64(proposalID))}
+	hash, err := gov.netMan.SendEsdtTransaction(pk, gov.contractAddress, amount, actionGasLimit, token, "depositTokensForProposal", args, utils.AutoNonce)
+	if err != nil {
+		return err
+	}
+
+	return gov.netMan.GetTxResult(hash)
+}
+
+func (gov *Governance) Queue(pk []byte, proposalID uint32) error {
+	if gov.version != VersionV2 {
+		return utils.ErrNotSupported
+	}
+
+	return gov.sendTransaction(pk, actionGasLimit, "queue@"+utils.Uint64Arg(uint64(proposalID)))
+}
+
+func (gov *Governance) Execute(pk []byte, proposalID uint32) error {
+	proposal, err := gov.GetProposal(proposalID)
+	if err != nil {
+		return err
+	}
+
+	gasLimit := actionGasLimit
+	for _, action := range proposal.Actions {
+		gasLimit += action.GasLimit
+	}
+
+	return gov.sendTransaction(pk, gasLimit, "execute@"+utils.Uint64Arg(uint64(proposalID)))
+}
+
+func (gov *Governance) Cancel(pk []byte, proposalID uint32) error {
+	if gov.version != VersionV2 {
+		return utils.ErrNotSupported
+	}
+
+	return gov.sendTransaction(pk, actionGasLimit, "cancel@"+utils.Uint64Arg(uint64(proposalID)))
+}
+
+func (gov *Governance) sendTransaction(pk []byte, gasLimit uint64, dataField string) error {
+	hash, err := gov.netMan.SendTransaction(pk, gov.contractAddress, 0, gasLimit, dataField, utils.AutoNonce)
+	if err != nil {
+		return err
+	}
+
+	return gov.netMan.GetTxResult(hash)
+}
@@ -0,0 +1,201 @@
+package liquidstaking
+
+import (
+	"math/big"
+
+	"github.com/stakingagency/sa-mx-sdk-go/accounts"
+	"github.com/stakingagency/sa-mx-sdk-go/data"
+	"github.com/stakingagency/sa-mx-sdk-go/network"
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+const (
+	delegateGasLimit   = uint64(30000000)
+	undelegateGasLimit = uint64(40000000)
+	withdrawGasLimit   = uint64(40000000)
+	egldDecimals       = 18
+)
+
+type contract struct {
+	netMan          *network.NetworkManager
+	contractAddress string
+}
+
+func (c *contract) GetContractAddress() string {
+	return c.contractAddress
+}
+
+func (c *contract) queryBigInt(function string, args []string) (*big.Int, error) {
+	return c.netMan.QueryScIntResult(c.contractAddress, function, args)
+}
+
+func (c *contract) queryString(function string) (string, error) {
+	res, err := c.netMan.QuerySC(c.contractAddress, function, nil)
+	if err != nil {
+		return "", err
+	}
+
+	if len(res.Data.ReturnData) == 0 {
+		return "", utils.ErrInvalidResponse
+	}
+
+	return string(res.Data.ReturnData[0]), nil
+}
+
+func (c *contract) queryEgld(function string) (float64, error) {
+	iValue, err := c.queryBigInt(function, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	return utils.Denominate(iValue, egldDecimals), nil
+}
+
+func (c *contract) delegate(pk []byte, amount float64) error {
+	hash, err := c.netMan.SendTransaction(pk, c.contractAddress, amount, delegateGasLimit, "delegate", utils.AutoNonce)
+	if err != nil {
+		return err
+	}
+
+	return c.netMan.GetTxResult(hash)
+}
+
+func (c *contract) undelegate(pk []byte, token string, amount float64) error {
+	esdt := &data.ESDT{
+		Ticker:   token,
+		Decimals: egldDecimals,
+	}
+	hash, err := c.netMan.SendEsdtTransaction(pk, c.contractAddress, amount, undelegateGasLimit, esdt, "unDelegate", nil, utils.AutoNonce)
+	if err != nil {
+		return err
+	}
+
+	return c.netMan.GetTxResult(hash)
+}
+
+func (c *contract) withdrawNfts(pk []byte, undelegations []*data.LiquidUndelegation, collection string) error {
+	sent := false
+	for _, undelegation := range undelegations {
+		if !undelegation.Withdrawable {
+			continue
+		}
+
+		hash, err := c.netMan.SendEsdtNftTransaction(pk, c.contractAddress, collection, undelegation.TokenNonce, big.NewInt(1), withdrawGasLimit, "withdraw", nil, utils.AutoNonce)
+		if err != nil {
+			return err
+		}
+
+		err = c.netMan.GetTxResult(hash)
+		if err != nil {
+			return err
+		}
+
+		sent = true
+	}
+	if !sent {
+		return utils.ErrNothingToWithdraw
+	}
+
+	return nil
+}
+
+func (c *contract) getCurrentEpoch() (uint64, error) {
+	netStatus, err := c.netMan.GetNetworkStatus()
+	if err != nil {
+		return 0, err
+	}
+
+	return netStatus.EpochNumber, nil
+}
+
+func (c *contract) getTokenBalance(address string, token string) (float64, error) {
+	account, err := accounts.NewAccount(address, c.netMan, utils.NoRefresh)
+	if err != nil {
+		return 0, err
+	}
+
+	balances, err := account.GetTokensBalances()
+	if err != nil {
+		return 0, err
+	}
+
+	return balances[token], nil
+}
+
+func (c *contract) getNftUndelegations(address string, collection string, parse func(attributes []byte) (*big.Int, uint64, bool)) ([]*data.LiquidUndelegation, error) {
+	account, err := accounts.NewAccount(address, c.netMan, utils.NoRefresh)
+	if err != nil {
+		return nil, err
+	}
+
+	nfts, err := account.GetCollectionNFTs(collection)
+	if err != nil {
+		return nil, err
+	}
+
+	epoch, err := c.getCurrentEpoch()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*data.LiquidUndelegation, 0, len(nfts))
+	for _, nft := range nfts {
+		amount, unbondEpoch, ok := parse(nft.Attributes)
+		if !ok {
+			log.Warn("invalid undelegation attributes", "identifier", nft.Identifier, "function", "getNftUndelegations")
+			continue
+		}
+
+		res = append(res, &data.LiquidUndelegation{
+			Amount:       utils.Denominate(amount, egldDecimals),
+			UnbondEpoch:  unbondEpoch,
+			Withdrawable: unbondEpoch <= epoch,
+			TokenNonce:   nft.Nonce,
+		})
+	}
+
+	return res, nil
+}
+
+func newPosition(protocol Protocol, address string, token string, balance float64, rate float64, undelegations []*data.LiquidUndelegation) *data.LiquidStakingPosition {
+	position := &data.LiquidStakingPosition{
+		Protocol:      protocol.GetName(),
+		Address:       address,
+		LiquidToken:   token,
+		LiquidBalance: balance,
+		EgldValue:     balance * rate,
+	}
+	for _, undelegation := range undelegations {
+		if undelegation.Withdrawable {
+			position.Withdrawable += undelegation.Amount
+		} else {
+			position.Undelegating += undelegation.Amount
+		}
+	}
+
+	return position
+}
+
+func getUserPosition(protocol Protocol, c *contract, address string) (*data.LiquidStakingPosition, error) {
+	token, err := protocol.GetLiquidToken()
+	if err != nil {
+		return nil, err
+	}
+
+	rate, err := protocol.GetExchangeRate()
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := c.getTokenBalance(address, token)
+	if err != nil {
+		return nil, err
+	}
+
+	undelegations, err := protocol.GetUndelegations(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPosition(protocol, address, token, balance, rate, undelegations), nil
+}
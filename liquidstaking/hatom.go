@@ -0,0 +1,145 @@
+package liquidstaking
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/stakingagency/sa-mx-sdk-go/data"
+	"github.com/stakingagency/sa-mx-sdk-go/network"
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+const HatomProtocol = "Hatom"
+
+type Hatom struct {
+	contract
+
+	tokensMut       sync.Mutex
+	liquidToken     string
+	undelegateToken string
+}
+
+func NewHatom(netMan *network.NetworkManager, contractAddress string) *Hatom {
+	return &Hatom{
+		contract: contract{
+			netMan:          netMan,
+			contractAddress: contractAddress,
+		},
+	}
+}
+
+func (h *Hatom) GetName() string {
+	return HatomProtocol
+}
+
+func (h *Hatom) GetLiquidToken() (string, error) {
+	h.tokensMut.Lock()
+	defer h.tokensMut.Unlock()
+	if h.liquidToken != "" {
+		return h.liquidToken, nil
+	}
+
+	token, err := h.queryString("getLsTokenId")
+	if err != nil {
+		return "", err
+	}
+
+	h.liquidToken = token
+
+	return token, nil
+}
+
+func (h *Hatom) GetUndelegateToken() (string, error) {
+	h.tokensMut.Lock()
+	defer h.tokensMut.Unlock()
+	if h.undelegateToken != "" {
+		return h.undelegateToken, nil
+	}
+
+	token, err := h.queryString("getUndelegateTokenId")
+	if err != nil {
+		return "", err
+	}
+
+	h.undelegateToken = token
+
+	return token, nil
+}
+
+func (h *Hatom) GetExchangeRate() (float64, error) {
+	return h.queryEgld("getExchangeRate")
+}
+
+func (h *Hatom) GetTVL() (float64, error) {
+	shares, err := h.queryEgld("getTotalShares")
+	if err != nil {
+		return 0, err
+	}
+
+	rate, err := h.GetExchangeRate()
+	if err != nil {
+		return 0, err
+	}
+
+	return shares * rate, nil
+}
+
+func (h *Hatom) GetUserPosition(address string) (*data.LiquidStakingPosition, error) {
+	return getUserPosition(h, &h.contract, address)
+}
+
+func (h *Hatom) GetUndelegations(address string) ([]*data.LiquidUndelegation, error) {
+	collection, err := h.GetUndelegateToken()
+	if err != nil {
+		return nil, err
+	}
+
+	// attributes: delegation contract, egld amount, unbond epoch
+	return h.getNftUndelegations(address, collection, func(attributes []byte) (*big.Int, uint64, bool) {
+		_, idx, ok := utils.ParsePubkey(attributes, 0)
+		if !ok {
+			return nil, 0, false
+		}
+
+		amount, idx, ok := utils.ParseBigInt(attributes, idx)
+		if !ok {
+			return nil, 0, false
+		}
+
+		unbondEpoch, _, ok := utils.ParseUint64(attributes, idx)
+
+		return amount, unbondEpoch, ok
+	})
+}
+
+func (h *Hatom) Delegate(pk []byte, amount float64) error {
+	return h.delegate(pk, amount)
+}
+
+func (h *Hatom) Undelegate(pk []byte, amount float64) error {
+	token, err := h.GetLiquidToken()
+	if err != nil {
+		return err
+	}
+
+	return h.undelegate(pk, token, amount)
+}
+
+func (h *Hatom) Withdraw(pk []byte) error {
+	address, err := network.GetAddressFromPrivateKey(pk)
+	if err != nil {
+		return err
+	}
+
+	undelegations, err := h.GetUndelegations(address)
+	if err != nil {
+		return err
+	}
+
+	collection, err := h.GetUndelegateToken()
+	if err != nil {
+		return err
+	}
+
+	return h.withdrawNfts(pk, undelegations, collection)
+}
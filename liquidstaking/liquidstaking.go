@@ -0,0 +1,188 @@
+package liquidstaking
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/stakingagency/sa-mx-sdk-go/data"
+	"github.com/stakingagency/sa-mx-sdk-go/network"
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+type Protocol interface {
+	GetName() string
+	GetContractAddress() string
+	GetLiquidToken() (string, error)
+	GetExchangeRate() (float64, error)
+	GetTVL() (float64, error)
+	GetUserPosition(address string) (*data.LiquidStakingPosition, error)
+	GetUndelegations(address string) ([]*data.LiquidUndelegation, error)
+	Delegate(pk []byte, amount float64) error
+	Undelegate(pk []byte, amount float64) error
+	Withdraw(pk []byte) error
+}
+
+type (
+	ExchangeRateChangedCallbackFunc func(protocol string, oldRate float64, newRate float64)
+)
+
+type LiquidStaking struct {
+	netMan          *network.NetworkManager
+	refreshInterval time.Duration
+
+	protocols    map[string]Protocol
+	protocolsMut sync.Mutex
+
+	cachedRates    map[string]float64
+	cachedRatesMut sync.Mutex
+
+	exchangeRateChangedCallback ExchangeRateChangedCallbackFunc
+}
+
+var log = logger.GetOrCreate("liquidstaking")
+
+var ErrUnknownProtocol = errors.New("unknown liquid staking protocol")
+
+func NewLiquidStaking(netMan *network.NetworkManager, refreshInterval time.Duration) (*LiquidStaking, error) {
+	ls := &LiquidStaking{
+		netMan:          netMan,
+		refreshInterval: refreshInterval,
+
+		protocols:   make(map[string]Protocol),
+		cachedRates: make(map[string]float64),
+
+		exchangeRateChangedCallback: nil,
+	}
+	ls.AddProtocol(NewSalsa(netMan, utils.SalsaSC))
+	ls.AddProtocol(NewHatom(netMan, utils.HatomLiquidSC))
+	ls.startTasks()
+
+	return ls, nil
+}
+
+func (ls *LiquidStaking) SetExchangeRateChangedCallback(f ExchangeRateChangedCallbackFunc) {
+	ls.exchangeRateChangedCallback = f
+}
+
+func (ls *LiquidStaking) AddProtocol(protocol Protocol) {
+	ls.protocolsMut.Lock()
+	ls.protocols[protocol.GetName()] = protocol
+	ls.protocolsMut.Unlock()
+}
+
+func (ls *LiquidStaking) GetProtocol(name string) (Protocol, error) {
+	ls.protocolsMut.Lock()
+	protocol := ls.protocols[name]
+	ls.protocolsMut.Unlock()
+	if protocol == nil {
+		return nil, ErrUnknownProtocol
+	}
+
+	return protocol, nil
+}
+
+func (ls *LiquidStaking) GetProtocols() []Protocol {
+	ls.protocolsMut.Lock()
+	res := make([]Protocol, 0, len(ls.protocols))
+	for _, protocol := range ls.protocols {
+		res = append(res, protocol)
+	}
+	ls.protocolsMut.Unlock()
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].GetName() < res[j].GetName()
+	})
+
+	return res
+}
+
+func (ls *LiquidStaking) GetProtocolsInfo() ([]*data.LiquidStakingInfo, error) {
+	res := make([]*data.LiquidStakingInfo, 0)
+	for _, protocol := range ls.GetProtocols() {
+		info, err := getProtocolInfo(protocol)
+		if err != nil {
+			log.Warn("get protocol info", "error", err, "protocol", protocol.GetName(), "function", "GetProtocolsInfo")
+			continue
+		}
+
+		res = append(res, info)
+	}
+
+	return res, nil
+}
+
+func (ls *LiquidStaking) GetExchangeRates() (map[string]float64, error) {
+	res := make(map[string]float64)
+	for _, protocol := range ls.GetProtocols() {
+		rate, err := protocol.GetExchangeRate()
+		if err != nil {
+			log.Warn("get exchange rate", "error", err, "protocol", protocol.GetName(), "function", "GetExchangeRates")
+			continue
+		}
+
+		res[protocol.GetName()] = rate
+	}
+
+	return res, nil
+}
+
+func (ls *LiquidStaking) GetCachedExchangeRates() (map[string]float64, error) {
+	if ls.refreshInterval == utils.NoRefresh {
+		return nil, utils.ErrRefreshIntervalNotSet
+	}
+
+	res := make(map[string]float64)
+	ls.cachedRatesMut.Lock()
+	for k, v := range ls.cachedRates {
+		res[k] = v
+	}
+	ls.cachedRatesMut.Unlock()
+
+	return res, nil
+}
+
+func (ls *LiquidStaking) GetUserPositions(address string) ([]*data.LiquidStakingPosition, error) {
+	res := make([]*data.LiquidStakingPosition, 0)
+	for _, protocol := range ls.GetProtocols() {
+		position, err := protocol.GetUserPosition(address)
+		if err != nil {
+			log.Warn("get user position", "error", err, "protocol", protocol.GetName(), "function", "GetUserPositions")
+			continue
+		}
+
+		if position.LiquidBalance == 0 && position.Undelegating == 0 && position.Withdrawable == 0 {
+			continue
+		}
+
+		res = append(res, position)
+	}
+
+	return res, nil
+}
+
+func getProtocolInfo(protocol Protocol) (*data.LiquidStakingInfo, error) {
+	token, err := protocol.GetLiquidToken()
+	if err != nil {
+		return nil, err
+	}
+
+	rate, err := protocol.GetExchangeRate()
+	if err != nil {
+		return nil, err
+	}
+
+	tvl, err := protocol.GetTVL()
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.LiquidStakingInfo{
+		Protocol:        protocol.GetName(),
+		ContractAddress: protocol.GetContractAddress(),
+		LiquidToken:     token,
+		ExchangeRate:    rate,
+		TVL:             tvl,
+	}, nil
+}
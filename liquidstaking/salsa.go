@@ -0,0 +1,124 @@
+package liquidstaking
+
+import (
+	"sync"
+
+	sdkData "github.com/multiversx/mx-sdk-go/data"
+	"github.com/stakingagency/sa-mx-sdk-go/data"
+	"github.com/stakingagency/sa-mx-sdk-go/liquidstaking/salsaContract"
+	"github.com/stakingagency/sa-mx-sdk-go/network"
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+const SalsaProtocol = "Salsa"
+
+type Salsa struct {
+	contract
+	sc *salsaContract.SalsaContract
+
+	liquidToken    string
+	liquidTokenMut sync.Mutex
+}
+
+func NewSalsa(netMan *network.NetworkManager, contractAddress string) *Salsa {
+	return &Salsa{
+		contract: contract{
+			netMan:          netMan,
+			contractAddress: contractAddress,
+		},
+		sc: salsaContract.NewSalsaContractWithNetworkManager(contractAddress, netMan),
+	}
+}
+
+func (s *Salsa) GetName() string {
+	return SalsaProtocol
+}
+
+func (s *Salsa) GetLiquidToken() (string, error) {
+	s.liquidTokenMut.Lock()
+	defer s.liquidTokenMut.Unlock()
+	if s.liquidToken != "" {
+		return s.liquidToken, nil
+	}
+
+	token, err := s.sc.GetLiquidTokenId()
+	if err != nil {
+		return "", err
+	}
+
+	s.liquidToken = string(token)
+
+	return s.liquidToken, nil
+}
+
+func (s *Salsa) GetExchangeRate() (float64, error) {
+	price, err := s.sc.GetTokenPrice()
+	if err != nil {
+		return 0, err
+	}
+
+	return utils.Denominate(price, egldDecimals), nil
+}
+
+func (s *Salsa) GetTVL() (float64, error) {
+	staked, err := s.sc.GetTotalEgldStaked()
+	if err != nil {
+		return 0, err
+	}
+
+	return utils.Denominate(staked, egldDecimals), nil
+}
+
+func (s *Salsa) GetUserPosition(address string) (*data.LiquidStakingPosition, error) {
+	return getUserPosition(s, &s.contract, address)
+}
+
+func (s *Salsa) GetUndelegations(address string) ([]*data.LiquidUndelegation, error) {
+	addr, err := sdkData.NewAddressFromBech32String(address)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.sc.GetUserUndelegations(addr.AddressBytes())
+	if err != nil {
+		return nil, err
+	}
+
+	epoch, err := s.getCurrentEpoch()
+	if err != nil {
+		return nil, err
+	}
+
+	undelegations := make([]*data.LiquidUndelegation, 0, len(res))
+	for _, item := range res {
+		undelegations = append(undelegations, &data.LiquidUndelegation{
+			Amount:       utils.Denominate(item.Amount, egldDecimals),
+			UnbondEpoch:  item.Unbond_epoch,
+			Withdrawable: item.Unbond_epoch <= epoch,
+		})
+	}
+
+	return undelegations, nil
+}
+
+func (s *Salsa) Delegate(pk []byte, amount float64) error {
+	return s.sc.Delegate(pk, amount, delegateGasLimit, nil, utils.AutoNonce, false, false)
+}
+
+func (s *Salsa) Undelegate(pk []byte, amount float64) error {
+	token, err := s.GetLiquidToken()
+	if err != nil {
+		return err
+	}
+
+	esdt := &data.ESDT{
+		Ticker:   token,
+		Decimals: egldDecimals,
+	}
+
+	return s.sc.UnDelegate(pk, amount, undelegateGasLimit, esdt, utils.AutoNonce, utils.Renominate(amount, egldDecimals), false)
+}
+
+func (s *Salsa) Withdraw(pk []byte) error {
+	return s.sc.Withdraw(pk, 0, withdrawGasLimit, nil, utils.AutoNonce)
+}
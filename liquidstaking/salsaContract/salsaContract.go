@@ -0,0 +1,1489 @@
+package salsaContract
+
+import (
+    "math/big"
+    "github.com/stakingagency/sa-mx-sdk-go/network"
+    "encoding/hex"
+    "github.com/stakingagency/sa-mx-sdk-go/utils"
+    "errors"
+    "github.com/stakingagency/sa-mx-sdk-go/data"
+    "strings"
+    "encoding/binary"
+)
+
+type TokenIdentifier string
+
+type Address []byte
+
+type UserInfo struct {
+    Undelegations []Undelegation
+    Reserve *big.Int
+    Add_reserve_epoch uint64
+    Delegation *big.Int
+    Knight Knight
+    Knight_users []Knight
+    Heir Heir
+    Heir_users []Heir
+}
+
+type ContractInfo struct {
+    State State
+    Liquid_token_id TokenIdentifier
+    Liquid_token_supply *big.Int
+    Total_egld_staked *big.Int
+    Provider_address Address
+    Egld_reserve *big.Int
+    Available_egld_reserve *big.Int
+    Unbond_period uint64
+    Undelegate_now_fee uint64
+    Token_price *big.Int
+}
+
+type Heir struct {
+    Address Address
+    Inheritance_epochs uint64
+    Last_accessed_epoch uint64
+}
+
+type Knight struct {
+    Address Address
+    State KnightState
+}
+
+type Undelegation struct {
+    Amount *big.Int
+    Unbond_epoch uint64
+}
+
+type State int
+
+const (
+    Inactive State = 0
+    Active State = 1
+)
+
+type KnightState int
+
+const (
+    Undefined KnightState = 0
+    InactiveKnight KnightState = 1
+    PendingConfirmation KnightState = 2
+    ActiveKnight KnightState = 3
+)
+
+type SalsaContract struct {
+    netMan *network.NetworkManager
+    contractAddress string
+}
+
+func NewSalsaContract(contractAddress string, proxyAddress string, indexAddress string) (*SalsaContract, error) {
+    netMan, err := network.NewNetworkManager(proxyAddress, indexAddress)
+    if err != nil {
+        return nil, err
+    }
+
+    contract := &SalsaContract{
+        netMan:          netMan,
+        contractAddress: contractAddress,
+    }
+
+    return contract, nil
+}
+
+func NewSalsaContractWithNetworkManager(contractAddress string, netMan *network.NetworkManager) *SalsaContract {
+    return &SalsaContract{
+        netMan:          netMan,
+        contractAddress: contractAddress,
+    }
+}
+
+func (contract *SalsaContract) GetNetworkManager() *network.NetworkManager {
+  return contract.netMan
+}
+func (contract *SalsaContract) GetLiquidTokenId() (TokenIdentifier, error) {
+    res, err := contract.netMan.QuerySC(contract.contractAddress, "getLiquidTokenId", nil)
+    if err != nil {
+        return "", err
+    }
+
+    res0 := TokenIdentifier(res.Data.ReturnData[0])
+
+    return res0, nil
+}
+
+func (contract *SalsaContract) GetLiquidTokenSupply() (*big.Int, error) {
+    res, err := contract.netMan.QuerySC(contract.contractAddress, "getLiquidTokenSupply", nil)
+    if err != nil {
+        return nil, err
+    }
+
+    res0 := big.NewInt(0).SetBytes(res.Data.ReturnData[0])
+
+    return res0, nil
+}
+
+func (contract *SalsaContract) GetState() (State, error) {
+    res, err := contract.netMan.QuerySC(contract.contractAddress, "getState", nil)
+    if err != nil {
+        return 0, err
+    }
+
+    res0 := State(big.NewInt(0).SetBytes(res.Data.ReturnData[0]).Uint64())
+
+    return res0, nil
+}
+
+func (contract *SalsaContract) GetProviderAddress() (Address, error) {
+    res, err := contract.netMan.QuerySC(contract.contractAddress, "getProviderAddress", nil)
+    if err != nil {
+        return nil, err
+    }
+
+    res0 := res.Data.ReturnData[0]
+
+    return res0, nil
+}
+
+func (contract *SalsaContract) GetUnbondPeriod() (uint64, error) {
+    res, err := contract.netMan.QuerySC(contract.contractAddress, "getUnbondPeriod", nil)
+    if err != nil {
+        return 0, err
+    }
+
+    res0 := big.NewInt(0).SetBytes(res.Data.ReturnData[0]).Uint64()
+
+    return res0, nil
+}
+
+func (contract *SalsaContract) GetUserUndelegations(user Address) ([]Undelegation, error) {
+    _args := make([]string, 0)
+    _args = append(_args, hex.EncodeToString(user))
+    res, err := contract.netMan.QuerySC(contract.contractAddress, "getUserUndelegations", _args)
+    if err != nil {
+        return nil, err
+    }
+
+    res0 := make([]Undelegation, 0)
+    for i := 0; i < len(res.Data.ReturnData); i++ {
+        idx := 0
+        ok, allOk := true, true
+        _Amount, idx, ok := utils.ParseBigInt(res.Data.ReturnData[i], idx)
+        allOk = allOk && ok
+        _Unbond_epoch, idx, ok := utils.ParseUint64(res.Data.ReturnData[i], idx)
+        allOk = allOk && ok
+        if !allOk {
+            return nil, errors.New("invalid response")
+        }
+
+        _item := Undelegation{
+            Amount: _Amount,
+            Unbond_epoch: _Unbond_epoch,
+        }
+        res0 = append(res0, _item)
+    }
+
+    return res0, nil
+}
+
+func (contract *SalsaContract) GetTotalEgldStaked() (*big.Int, error) {
+    res, err := contract.netMan.QuerySC(contract.contractAddress, "getTotalEgldStaked", nil)
+    if err != nil {
+        return nil, err
+    }
+
+    res0 := big.NewInt(0).SetBytes(res.Data.ReturnData[0])
+
+    return res0, nil
+}
+
+func (contract *SalsaContract) GetUserWithdrawnEgld() (*big.Int, error) {
+    res, err := contract.netMan.QuerySC(contract.contractAddress, "getUserWithdrawnEgld", nil)
+    if err != nil {
+        return nil, err
+    }
+
+    res0 := big.NewInt(0).SetBytes(res.Data.ReturnData[0])
+
+    return res0, nil
+}
+
+func (contract *SalsaContract) GetTotalWithdrawnEgld() (*big.Int, error) {
+    res, err := contract.netMan.QuerySC(contract.contractAddress, "getTotalWithdrawnEgld", nil)
+    if err != nil {
+        return nil, err
+    }
+
+    res0 := big.NewInt(0).SetBytes(res.Data.ReturnData[0])
+
+    return res0, nil
+}
+
+func (contract *SalsaContract) GetTotalUserUndelegations() ([]Undelegation, error) {
+    res, err := contract.netMan.QuerySC(contract.contractAddress, "getTotalUserUndelegations", nil)
+    if err != nil {
+        return nil, err
+    }
+
+    res0 := make([]Undelegation, 0)
+    for i := 0; i < len(res.Data.ReturnData); i++ {
+        idx := 0
+        ok, allOk := true, true
+        _Amount, idx, ok := utils.ParseBigInt(res.Data.ReturnData[i], idx)
+        allOk = allOk && ok
+        _Unbond_epoch, idx, ok := utils.ParseUint64(res.Data.ReturnData[i], idx)
+        allOk = allOk && ok
+        if !allOk {
+            return nil, errors.New("invalid response")
+        }
+
+        _item := Undelegation{
+            Amount: _Amount,
+            Unbond_epoch: _Unbond_epoch,
+        }
+        res0 = append(res0, _item)
+    }
+
+    return res0, nil
+}
+
+func (contract *SalsaContract) GetEgldReserve() (*big.Int, error) {
+    res, err := contract.netMan.QuerySC(contract.contractAddress, "getEgldReserve", nil)
+    if err != nil {
+        return nil, err
+    }
+
+    res0 := big.NewInt(0).SetBytes(res.Data.ReturnData[0])
+
+    return res0, nil
+}
+
+func (contract *SalsaContract) GetReservePoints() (*big.Int, error) {
+    res, err := contract.netMan.QuerySC(contract.contractAddress, "getReservePoints", nil)
+    if err != nil {
+        return nil, err
+    }
+
+    res0 := big.NewInt(0).SetBytes(res.Data.ReturnData[0])
+
+    return res0, nil
+}
+
+func (contract *SalsaContract) GetAvailableEgldReserve() (*big.Int, error) {
+    res, err := contract.netMan.QuerySC(contract.contractAddress, "getAvailableEgldReserve", nil)
+    if err != nil {
+        return nil, err
+    }
+
+    res0 := big.NewInt(0).SetBytes(res.Data.ReturnData[0])
+
+    return res0, nil
+}
+
+func (contract *SalsaContract) GetReserveUndelegations() ([]Undelegation, error) {
+    res, err := contract.netMan.QuerySC(contract.contractAddress, "getReserveUndelegations", nil)
+    if err != nil {
+        return nil, err
+    }
+
+    res0 := make([]Undelegation, 0)
+    for i := 0; i < len(res.Data.ReturnData); i++ {
+        idx := 0
+        ok, allOk := true, true
+        _Amount, idx, ok := utils.ParseBigInt(res.Data.ReturnData[i], idx)
+        allOk = allOk && ok
+        _Unbond_epoch, idx, ok := utils.ParseUint64(res.Data.ReturnData[i], idx)
+        allOk = allOk && ok
+        if !allOk {
+            return nil, errors.New("invalid response")
+        }
+
+        _item := Undelegation{
+            Amount: _Amount,
+            Unbond_epoch: _Unbond_epoch,
+        }
+        res0 = append(res0, _item)
+    }
+
+    return res0, nil
+}
+
+func (contract *SalsaContract) GetUsersReservePoints(user Address) (*big.Int, error) {
+    _args := make([]string, 0)
+    _args = append(_args, hex.EncodeToString(user))
+    res, err := contract.netMan.QuerySC(contract.contractAddress, "getUsersReservePoints", _args)
+    if err != nil {
+        return nil, err
+    }
+
+    res0 := big.NewInt(0).SetBytes(res.Data.ReturnData[0])
+
+    return res0, nil
+}
+
+func (contract *SalsaContract) GetUndelegateNowFee() (uint64, error) {
+    res, err := contract.netMan.QuerySC(contract.contractAddress, "getUndelegateNowFee", nil)
+    if err != nil {
+        return 0, err
+    }
+
+    res0 := big.NewInt(0).SetBytes(res.Data.ReturnData[0]).Uint64()
+
+    return res0, nil
+}
+
+func (contract *SalsaContract) GetReservePointsAmount(egld_amount *big.Int) (*big.Int, error) {
+    _args := make([]string, 0)
+    _args = append(_args, hex.EncodeToString(egld_amount.Bytes()))
+    res, err := contract.netMan.QuerySC(contract.contractAddress, "getReservePointsAmount", _args)
+    if err != nil {
+        return nil, err
+    }
+
+    res0 := big.NewInt(0).SetBytes(res.Data.ReturnData[0])
+
+    return res0, nil
+}
+
+func (contract *SalsaContract) GetReserveEgldAmount(points_amount *big.Int) (*big.Int, error) {
+    _args := make([]string, 0)
+    _args = append(_args, hex.EncodeToString(points_amount.Bytes()))
+    res, err := contract.netMan.QuerySC(contract.contractAddress, "getReserveEgldAmount", _args)
+    if err != nil {
+        return nil, err
+    }
+
+    res0 := big.NewInt(0).SetBytes(res.Data.ReturnData[0])
+
+    return res0, nil
+}
+
+func (contract *SalsaContract) GetUserReserve(user Address) (*big.Int, error) {
+    _args := make([]string, 0)
+    _args = append(_args, hex.EncodeToString(user))
+    res, err := contract.netMan.QuerySC(contract.contractAddress, "getUserReserve", _args)
+    if err != nil {
+        return nil, err
+    }
+
+    res0 := big.NewInt(0).SetBytes(res.Data.ReturnData[0])
+
+    return res0, nil
+}
+
+func (contract *SalsaContract) GetTokenPrice() (*big.Int, error) {
+    res, err := contract.netMan.QuerySC(contract.contractAddress, "getTokenPrice", nil)
+    if err != nil {
+        return nil, err
+    }
+
+    res0 := big.NewInt(0).SetBytes(res.Data.ReturnData[0])
+
+    return res0, nil
+}
+
+func (contract *SalsaContract) GetLegldInCustody() (*big.Int, error) {
+    res, err := contract.netMan.QuerySC(contract.contractAddress, "getLegldInCustody", nil)
+    if err != nil {
+        return nil, err
+    }
+
+    res0 := big.NewInt(0).SetBytes(res.Data.ReturnData[0])
+
+    return res0, nil
+}
+
+func (contract *SalsaContract) GetUserDelegation(user Address) (*big.Int, error) {
+    _args := make([]string, 0)
+    _args = append(_args, hex.EncodeToString(user))
+    res, err := contract.netMan.QuerySC(contract.contractAddress, "getUserDelegation", _args)
+    if err != nil {
+        return nil, err
+    }
+
+    res0 := big.NewInt(0).SetBytes(res.Data.ReturnData[0])
+
+    return res0, nil
+}
+
+func (contract *SalsaContract) GetUserKnight(user Address) (Knight, error) {
+    _args := make([]string, 0)
+    _args = append(_args, hex.EncodeToString(user))
+    res, err := contract.netMan.QuerySC(contract.contractAddress, "getUserKnight", _args)
+    if err != nil {
+        return Knight{}, err
+    }
+
+    idx := 0
+    ok, allOk := true, true
+    _Address, idx, ok := utils.ParsePubkey(res.Data.ReturnData[0], idx)
+    allOk = allOk && ok
+    _State, idx, ok := utils.ParseByte(res.Data.ReturnData[0], idx)
+    allOk = allOk && ok
+    if !allOk {
+        return Knight{}, errors.New("invalid response")
+    }
+
+    res0 := Knight{
+        Address: Address(_Address),
+        State: KnightState(_State),
+    }
+
+    return res0, nil
+}
+
+func (contract *SalsaContract) GetKnightUsers(knight Address) ([]Address, error) {
+    _args := make([]string, 0)
+    _args = append(_args, hex.EncodeToString(knight))
+    res, err := contract.netMan.QuerySC(contract.contractAddress, "getKnightUsers", _args)
+    if err != nil {
+        return nil, err
+    }
+
+    res0 := make([]Address, 0)
+    for i := 0; i < len(res.Data.ReturnData); i++ {
+        _item := res.Data.ReturnData[i]
+        res0 = append(res0, _item)
+    }
+
+    return res0, nil
+}
+
+func (contract *SalsaContract) GetUserHeir(user Address) (Heir, error) {
+    _args := make([]string, 0)
+    _args = append(_args, hex.EncodeToString(user))
+    res, err := contract.netMan.QuerySC(contract.contractAddress, "getUserHeir", _args)
+    if err != nil {
+        return Heir{}, err
+    }
+
+    idx := 0
+    ok, allOk := true, true
+    _Address, idx, ok := utils.ParsePubkey(res.Data.ReturnData[0], idx)
+    allOk = allOk && ok
+    _Inheritance_epochs, idx, ok := utils.ParseUint64(res.Data.ReturnData[0], idx)
+    allOk = allOk && ok
+    _Last_accessed_epoch, idx, ok := utils.ParseUint64(res.Data.ReturnData[0], idx)
+    allOk = allOk && ok
+    if !allOk {
+        return Heir{}, errors.New("invalid response")
+    }
+
+    res0 := Heir{
+        Address: Address(_Address),
+        Inheritance_epochs: _Inheritance_epochs,
+        Last_accessed_epoch: _Last_accessed_epoch,
+    }
+
+    return res0, nil
+}
+
+func (contract *SalsaContract) GetHeirUsers(heir Address) ([]Address, error) {
+    _args := make([]string, 0)
+    _args = append(_args, hex.EncodeToString(heir))
+    res, err := contract.netMan.QuerySC(contract.contractAddress, "getHeirUsers", _args)
+    if err != nil {
+        return nil, err
+    }
+
+    res0 := make([]Address, 0)
+    for i := 0; i < len(res.Data.ReturnData); i++ {
+        _item := res.Data.ReturnData[i]
+        res0 = append(res0, _item)
+    }
+
+    return res0, nil
+}
+
+func (contract *SalsaContract) GetContractInfo() (ContractInfo, error) {
+    res, err := contract.netMan.QuerySC(contract.contractAddress, "getContractInfo", nil)
+    if err != nil {
+        return ContractInfo{}, err
+    }
+
+    idx := 0
+    ok, allOk := true, true
+    _State, idx, ok := utils.ParseByte(res.Data.ReturnData[0], idx)
+    allOk = allOk && ok
+    _Liquid_token_id, idx, ok := utils.ParseString(res.Data.ReturnData[0], idx)
+    allOk = allOk && ok
+    _Liquid_token_supply, idx, ok := utils.ParseBigInt(res.Data.ReturnData[0], idx)
+    allOk = allOk && ok
+    _Total_egld_staked, idx, ok := utils.ParseBigInt(res.Data.ReturnData[0], idx)
+    allOk = allOk && ok
+    _Provider_address, idx, ok := utils.ParsePubkey(res.Data.ReturnData[0], idx)
+    allOk = allOk && ok
+    _Egld_reserve, idx, ok := utils.ParseBigInt(res.Data.ReturnData[0], idx)
+    allOk = allOk && ok
+    _Available_egld_reserve, idx, ok := utils.ParseBigInt(res.Data.ReturnData[0], idx)
+    allOk = allOk && ok
+    _Unbond_period, idx, ok := utils.ParseUint64(res.Data.ReturnData[0], idx)
+    allOk = allOk && ok
+    _Undelegate_now_fee, idx, ok := utils.ParseUint64(res.Data.ReturnData[0], idx)
+    allOk = allOk && ok
+    _Token_price, idx, ok := utils.ParseBigInt(res.Data.ReturnData[0], idx)
+    allOk = allOk && ok
+    if !allOk {
+        return ContractInfo{}, errors.New("invalid response")
+    }
+
+    res0 := ContractInfo{
+        State: State(_State),
+        Liquid_token_id: TokenIdentifier(_Liquid_token_id),
+        Liquid_token_supply: _Liquid_token_supply,
+        Total_egld_staked: _Total_egld_staked,
+        Provider_address: Address(_Provider_address),
+        Egld_reserve: _Egld_reserve,
+        Available_egld_reserve: _Available_egld_reserve,
+        Unbond_period: _Unbond_period,
+        Undelegate_now_fee: _Undelegate_now_fee,
+        Token_price: _Token_price,
+    }
+
+    return res0, nil
+}
+
+func (contract *SalsaContract) GetUserInfo(user Address) (UserInfo, error) {
+    _args := make([]string, 0)
+    _args = append(_args, hex.EncodeToString(user))
+    res, err := contract.netMan.QuerySC(contract.contractAddress, "getUserInfo", _args)
+    if err != nil {
+        return UserInfo{}, err
+    }
+
+    idx := 0
+    ok, allOk := true, true
+    _Undelegations := make([]Undelegation, 0)
+    var _len uint32
+    _len, idx, ok = utils.ParseUint32(res.Data.ReturnData[0], idx)
+    allOk = allOk && ok
+    for l := uint32(0); l < _len; l++ {
+        var _Amount *big.Int
+        var _Unbond_epoch uint64
+        _Amount, idx, ok = utils.ParseBigInt(res.Data.ReturnData[0], idx)
+        allOk = allOk && ok
+        _Unbond_epoch, idx, ok = utils.ParseUint64(res.Data.ReturnData[0], idx)
+        allOk = allOk && ok
+        if !allOk {
+            return UserInfo{}, errors.New("invalid response")
+        }
+
+        item := Undelegation{
+            Amount: _Amount,
+            Unbond_epoch: _Unbond_epoch,
+        }
+        _Undelegations = append(_Undelegations, item)
+    }
+    _Reserve, idx, ok := utils.ParseBigInt(res.Data.ReturnData[0], idx)
+    allOk = allOk && ok
+    _Add_reserve_epoch, idx, ok := utils.ParseUint64(res.Data.ReturnData[0], idx)
+    allOk = allOk && ok
+    _Delegation, idx, ok := utils.ParseBigInt(res.Data.ReturnData[0], idx)
+    allOk = allOk && ok
+    _Address, idx, ok := utils.ParsePubkey(res.Data.ReturnData[0], idx)
+    allOk = allOk && ok
+    _State, idx, ok := utils.ParseByte(res.Data.ReturnData[0], idx)
+    allOk = allOk && ok
+    if !allOk {
+        return UserInfo{}, errors.New("invalid response")
+    }
+
+    _Knight := Knight{
+        Address: Address(_Address),
+        State: KnightState(_State),
+    }
+    _Knight_users := make([]Knight, 0)
+    _len, idx, ok = utils.ParseUint32(res.Data.ReturnData[0], idx)
+    allOk = allOk && ok
+    for l := uint32(0); l < _len; l++ {
+        var _Address Address
+        var _State byte
+        _Address, idx, ok = utils.ParsePubkey(res.Data.ReturnData[0], idx)
+        allOk = allOk && ok
+        _State, idx, ok = utils.ParseByte(res.Data.ReturnData[0], idx)
+        allOk = allOk && ok
+        if !allOk {
+            return UserInfo{}, errors.New("invalid response")
+        }
+
+        item := Knight{
+            Address: Address(_Address),
+            State: KnightState(_State),
+        }
+        _Knight_users = append(_Knight_users, item)
+    }
+    _Address, idx, ok = utils.ParsePubkey(res.Data.ReturnData[0], idx)
+    allOk = allOk && ok
+    _Inheritance_epochs, idx, ok := utils.ParseUint64(res.Data.ReturnData[0], idx)
+    allOk = allOk && ok
+    _Last_accessed_epoch, idx, ok := utils.ParseUint64(res.Data.ReturnData[0], idx)
+    allOk = allOk && ok
+    if !allOk {
+        return UserInfo{}, errors.New("invalid response")
+    }
+
+    _Heir := Heir{
+        Address: Address(_Address),
+        Inheritance_epochs: _Inheritance_epochs,
+        Last_accessed_epoch: _Last_accessed_epoch,
+    }
+    _Heir_users := make([]Heir, 0)
+    _len, idx, ok = utils.ParseUint32(res.Data.ReturnData[0], idx)
+    allOk = allOk && ok
+    for l := uint32(0); l < _len; l++ {
+        var _Address Address
+        var _Inheritance_epochs uint64
+        var _Last_accessed_epoch uint64
+        _Address, idx, ok = utils.ParsePubkey(res.Data.ReturnData[0], idx)
+        allOk = allOk && ok
+        _Inheritance_epochs, idx, ok = utils.ParseUint64(res.Data.ReturnData[0], idx)
+        allOk = allOk && ok
+        _Last_accessed_epoch, idx, ok = utils.ParseUint64(res.Data.ReturnData[0], idx)
+        allOk = allOk && ok
+        if !allOk {
+            return UserInfo{}, errors.New("invalid response")
+        }
+
+        item := Heir{
+            Address: Address(_Address),
+            Inheritance_epochs: _Inheritance_epochs,
+            Last_accessed_epoch: _Last_accessed_epoch,
+        }
+        _Heir_users = append(_Heir_users, item)
+    }
+    if !allOk {
+        return UserInfo{}, errors.New("invalid response")
+    }
+
+    res0 := UserInfo{
+        Undelegations: _Undelegations,
+        Reserve: _Reserve,
+        Add_reserve_epoch: _Add_reserve_epoch,
+        Delegation: _Delegation,
+        Knight: _Knight,
+        Knight_users: _Knight_users,
+        Heir: _Heir,
+        Heir_users: _Heir_users,
+    }
+
+    return res0, nil
+}
+
+func (contract *SalsaContract) GetArbitrageState() (State, error) {
+    res, err := contract.netMan.QuerySC(contract.contractAddress, "getArbitrageState", nil)
+    if err != nil {
+        return 0, err
+    }
+
+    res0 := State(big.NewInt(0).SetBytes(res.Data.ReturnData[0]).Uint64())
+
+    return res0, nil
+}
+
+func (contract *SalsaContract) GetOnedexArbitrageState() (State, error) {
+    res, err := contract.netMan.QuerySC(contract.contractAddress, "getOnedexArbitrageState", nil)
+    if err != nil {
+        return 0, err
+    }
+
+    res0 := State(big.NewInt(0).SetBytes(res.Data.ReturnData[0]).Uint64())
+
+    return res0, nil
+}
+
+func (contract *SalsaContract) GetXexchangeArbitrageState() (State, error) {
+    res, err := contract.netMan.QuerySC(contract.contractAddress, "getXexchangeArbitrageState", nil)
+    if err != nil {
+        return 0, err
+    }
+
+    res0 := State(big.NewInt(0).SetBytes(res.Data.ReturnData[0]).Uint64())
+
+    return res0, nil
+}
+
+func (contract *SalsaContract) Delegate(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64, with_custody bool, without_arbitrage bool) error {
+    _args := make([]string, 0)
+    if with_custody {_args = append(_args, "01") } else {_args = append(_args, "00")}
+    if without_arbitrage {_args = append(_args, "01") } else {_args = append(_args, "00")}
+    dataField := "delegate" + "@" + strings.Join(_args, "@")
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+func (contract *SalsaContract) UnDelegate(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64, undelegate_amount *big.Int, without_arbitrage bool) error {
+    _args := make([]string, 0)
+    _args = append(_args, hex.EncodeToString(undelegate_amount.Bytes()))
+    if without_arbitrage {_args = append(_args, "01") } else {_args = append(_args, "00")}
+    hash, err := contract.netMan.SendEsdtTransaction(_pk, contract.contractAddress, _value, _gasLimit, _token, "unDelegate", _args, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+func (contract *SalsaContract) Withdraw(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64) error {
+    dataField := "withdraw"
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+func (contract *SalsaContract) AddToCustody(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64) error {
+    hash, err := contract.netMan.SendEsdtTransaction(_pk, contract.contractAddress, _value, _gasLimit, _token, "addToCustody", nil, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+func (contract *SalsaContract) RemoveFromCustody(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64, amount *big.Int) error {
+    _args := make([]string, 0)
+    _args = append(_args, hex.EncodeToString(amount.Bytes()))
+    dataField := "removeFromCustody" + "@" + strings.Join(_args, "@")
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+func (contract *SalsaContract) AddReserve(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64) error {
+    dataField := "addReserve"
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+func (contract *SalsaContract) RemoveReserve(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64, amount *big.Int) error {
+    _args := make([]string, 0)
+    _args = append(_args, hex.EncodeToString(amount.Bytes()))
+    dataField := "removeReserve" + "@" + strings.Join(_args, "@")
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+func (contract *SalsaContract) UnDelegateNow(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64, min_amount_out *big.Int, undelegate_amount *big.Int, without_arbitrage bool) error {
+    _args := make([]string, 0)
+    _args = append(_args, hex.EncodeToString(min_amount_out.Bytes()))
+    _args = append(_args, hex.EncodeToString(undelegate_amount.Bytes()))
+    if without_arbitrage {_args = append(_args, "01") } else {_args = append(_args, "00")}
+    hash, err := contract.netMan.SendEsdtTransaction(_pk, contract.contractAddress, _value, _gasLimit, _token, "unDelegateNow", _args, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+func (contract *SalsaContract) UnDelegateKnight(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64, user Address, undelegate_amount *big.Int, without_arbitrage bool) error {
+    _args := make([]string, 0)
+    _args = append(_args, hex.EncodeToString(user))
+    _args = append(_args, hex.EncodeToString(undelegate_amount.Bytes()))
+    if without_arbitrage {_args = append(_args, "01") } else {_args = append(_args, "00")}
+    dataField := "unDelegateKnight" + "@" + strings.Join(_args, "@")
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+func (contract *SalsaContract) UnDelegateNowKnight(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64, user Address, min_amount_out *big.Int, undelegate_amount *big.Int, without_arbitrage bool) error {
+    _args := make([]string, 0)
+    _args = append(_args, hex.EncodeToString(user))
+    _args = append(_args, hex.EncodeToString(min_amount_out.Bytes()))
+    _args = append(_args, hex.EncodeToString(undelegate_amount.Bytes()))
+    if without_arbitrage {_args = append(_args, "01") } else {_args = append(_args, "00")}
+    dataField := "unDelegateNowKnight" + "@" + strings.Join(_args, "@")
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+func (contract *SalsaContract) WithdrawKnight(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64, user Address) error {
+    _args := make([]string, 0)
+    _args = append(_args, hex.EncodeToString(user))
+    dataField := "withdrawKnight" + "@" + strings.Join(_args, "@")
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+func (contract *SalsaContract) RemoveReserveKnight(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64, user Address, amount *big.Int) error {
+    _args := make([]string, 0)
+    _args = append(_args, hex.EncodeToString(user))
+    _args = append(_args, hex.EncodeToString(amount.Bytes()))
+    dataField := "removeReserveKnight" + "@" + strings.Join(_args, "@")
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+func (contract *SalsaContract) UnDelegateHeir(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64, user Address, undelegate_amount *big.Int, without_arbitrage bool) error {
+    _args := make([]string, 0)
+    _args = append(_args, hex.EncodeToString(user))
+    _args = append(_args, hex.EncodeToString(undelegate_amount.Bytes()))
+    if without_arbitrage {_args = append(_args, "01") } else {_args = append(_args, "00")}
+    dataField := "unDelegateHeir" + "@" + strings.Join(_args, "@")
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+func (contract *SalsaContract) UnDelegateNowHeir(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64, user Address, min_amount_out *big.Int, undelegate_amount *big.Int, without_arbitrage bool) error {
+    _args := make([]string, 0)
+    _args = append(_args, hex.EncodeToString(user))
+    _args = append(_args, hex.EncodeToString(min_amount_out.Bytes()))
+    _args = append(_args, hex.EncodeToString(undelegate_amount.Bytes()))
+    if without_arbitrage {_args = append(_args, "01") } else {_args = append(_args, "00")}
+    dataField := "unDelegateNowHeir" + "@" + strings.Join(_args, "@")
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+func (contract *SalsaContract) WithdrawHeir(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64, user Address) error {
+    _args := make([]string, 0)
+    _args = append(_args, hex.EncodeToString(user))
+    dataField := "withdrawHeir" + "@" + strings.Join(_args, "@")
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+func (contract *SalsaContract) RemoveReserveHeir(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64, user Address, amount *big.Int) error {
+    _args := make([]string, 0)
+    _args = append(_args, hex.EncodeToString(user))
+    _args = append(_args, hex.EncodeToString(amount.Bytes()))
+    dataField := "removeReserveHeir" + "@" + strings.Join(_args, "@")
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+// only owner
+func (contract *SalsaContract) RegisterLiquidToken(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64, token_display_name string, token_ticker string, num_decimals uint32) error {
+    _args := make([]string, 0)
+    _args = append(_args, hex.EncodeToString([]byte(token_display_name)))
+    _args = append(_args, hex.EncodeToString([]byte(token_ticker)))
+    bytes232 := make([]byte, 4)
+    binary.BigEndian.PutUint32(bytes232, num_decimals)
+    _args = append(_args, hex.EncodeToString(bytes232))
+    dataField := "registerLiquidToken" + "@" + strings.Join(_args, "@")
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+// only owner
+func (contract *SalsaContract) SetStateActive(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64) error {
+    dataField := "setStateActive"
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+// only owner
+func (contract *SalsaContract) SetStateInactive(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64) error {
+    dataField := "setStateInactive"
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+// only owner
+func (contract *SalsaContract) SetProviderAddress(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64, address Address) error {
+    _args := make([]string, 0)
+    _args = append(_args, hex.EncodeToString(address))
+    dataField := "setProviderAddress" + "@" + strings.Join(_args, "@")
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+// only owner
+func (contract *SalsaContract) SetUnbondPeriod(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64, period uint64) error {
+    _args := make([]string, 0)
+    bytes064 := make([]byte, 8)
+    binary.BigEndian.PutUint64(bytes064, period)
+    _args = append(_args, hex.EncodeToString(bytes064))
+    dataField := "setUnbondPeriod" + "@" + strings.Join(_args, "@")
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+// only owner
+func (contract *SalsaContract) SetUndelegateNowFee(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64, new_fee uint64) error {
+    _args := make([]string, 0)
+    bytes064 := make([]byte, 8)
+    binary.BigEndian.PutUint64(bytes064, new_fee)
+    _args = append(_args, hex.EncodeToString(bytes064))
+    dataField := "setUndelegateNowFee" + "@" + strings.Join(_args, "@")
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+// only owner
+func (contract *SalsaContract) SetWrapSC(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64, address Address) error {
+    _args := make([]string, 0)
+    _args = append(_args, hex.EncodeToString(address))
+    dataField := "setWrapSC" + "@" + strings.Join(_args, "@")
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+func (contract *SalsaContract) UnDelegateAll(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64) error {
+    dataField := "unDelegateAll"
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+func (contract *SalsaContract) Compound(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64) error {
+    dataField := "compound"
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+func (contract *SalsaContract) WithdrawAll(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64) error {
+    dataField := "withdrawAll"
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+func (contract *SalsaContract) ComputeWithdrawn(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64) error {
+    dataField := "computeWithdrawn"
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+// only owner
+func (contract *SalsaContract) SetArbitrageActive(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64) error {
+    dataField := "setArbitrageActive"
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+// only owner
+func (contract *SalsaContract) SetArbitrageInactive(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64) error {
+    dataField := "setArbitrageInactive"
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+// only owner
+func (contract *SalsaContract) SetOnedexArbitrageActive(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64) error {
+    dataField := "setOnedexArbitrageActive"
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+// only owner
+func (contract *SalsaContract) SetOnedexArbitrageInactive(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64) error {
+    dataField := "setOnedexArbitrageInactive"
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+// only owner
+func (contract *SalsaContract) SetOnedexSC(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64, address Address) error {
+    _args := make([]string, 0)
+    _args = append(_args, hex.EncodeToString(address))
+    dataField := "setOnedexSC" + "@" + strings.Join(_args, "@")
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+// only owner
+func (contract *SalsaContract) SetOnedexPairId(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64, id uint32) error {
+    _args := make([]string, 0)
+    bytes032 := make([]byte, 4)
+    binary.BigEndian.PutUint32(bytes032, id)
+    _args = append(_args, hex.EncodeToString(bytes032))
+    dataField := "setOnedexPairId" + "@" + strings.Join(_args, "@")
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+// only owner
+func (contract *SalsaContract) SetXexchangeArbitrageActive(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64) error {
+    dataField := "setXexchangeArbitrageActive"
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+// only owner
+func (contract *SalsaContract) SetXexchangeArbitrageInactive(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64) error {
+    dataField := "setXexchangeArbitrageInactive"
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+// only owner
+func (contract *SalsaContract) SetXexchangeSC(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64, address Address) error {
+    _args := make([]string, 0)
+    _args = append(_args, hex.EncodeToString(address))
+    dataField := "setXexchangeSC" + "@" + strings.Join(_args, "@")
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+func (contract *SalsaContract) SetKnight(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64, knight Address) error {
+    _args := make([]string, 0)
+    _args = append(_args, hex.EncodeToString(knight))
+    dataField := "setKnight" + "@" + strings.Join(_args, "@")
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+func (contract *SalsaContract) CancelKnight(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64) error {
+    dataField := "cancelKnight"
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+func (contract *SalsaContract) ActivateKnight(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64) error {
+    dataField := "activateKnight"
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+func (contract *SalsaContract) DeactivateKnight(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64, user Address) error {
+    _args := make([]string, 0)
+    _args = append(_args, hex.EncodeToString(user))
+    dataField := "deactivateKnight" + "@" + strings.Join(_args, "@")
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+func (contract *SalsaContract) ConfirmKnight(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64, user Address) error {
+    _args := make([]string, 0)
+    _args = append(_args, hex.EncodeToString(user))
+    dataField := "confirmKnight" + "@" + strings.Join(_args, "@")
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+func (contract *SalsaContract) RemoveKnight(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64, user Address) error {
+    _args := make([]string, 0)
+    _args = append(_args, hex.EncodeToString(user))
+    dataField := "removeKnight" + "@" + strings.Join(_args, "@")
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+func (contract *SalsaContract) SetHeir(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64, heir Address, inheritance_epochs uint64) error {
+    _args := make([]string, 0)
+    _args = append(_args, hex.EncodeToString(heir))
+    bytes164 := make([]byte, 8)
+    binary.BigEndian.PutUint64(bytes164, inheritance_epochs)
+    _args = append(_args, hex.EncodeToString(bytes164))
+    dataField := "setHeir" + "@" + strings.Join(_args, "@")
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+func (contract *SalsaContract) CancelHeir(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64) error {
+    dataField := "cancelHeir"
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+func (contract *SalsaContract) RemoveHeir(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64, user Address) error {
+    _args := make([]string, 0)
+    _args = append(_args, hex.EncodeToString(user))
+    dataField := "removeHeir" + "@" + strings.Join(_args, "@")
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
+func (contract *SalsaContract) UpdateLastAccessed(_pk []byte, _value float64, _gasLimit uint64, _token *data.ESDT, _nonce uint64) error {
+    dataField := "updateLastAccessed"
+    hash, err := contract.netMan.SendTransaction(_pk, contract.contractAddress, _value, _gasLimit, dataField, _nonce)
+    if err != nil {
+        return err
+    }
+
+    err = contract.netMan.GetTxResult(hash)
+    if err != nil {
+        return err
+    }
+
+    return nil
+}
+
@@ -0,0 +1,53 @@
+package liquidstaking
+
+import (
+	"time"
+
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+var initialized = false
+
+func (ls *LiquidStaking) startTasks() {
+	if ls.refreshInterval == utils.NoRefresh {
+		return
+	}
+
+	go func() {
+		for {
+			startTime := time.Now().UnixNano()
+
+			ls.refreshExchangeRates()
+
+			endTime := time.Now().UnixNano()
+			waitTime := ls.refreshInterval - time.Duration(endTime-startTime)
+			if waitTime > 0 {
+				time.Sleep(waitTime)
+			}
+			initialized = true
+		}
+	}()
+}
+
+func (ls *LiquidStaking) refreshExchangeRates() {
+	newRates, err := ls.GetExchangeRates()
+	if err != nil {
+		log.Error("get exchange rates", "error", err, "function", "refreshExchangeRates")
+		return
+	}
+
+	ls.cachedRatesMut.Lock()
+	oldRates := ls.cachedRates
+	ls.cachedRates = newRates
+	ls.cachedRatesMut.Unlock()
+	if !initialized || ls.exchangeRateChangedCallback == nil {
+		return
+	}
+
+	for protocol, newRate := range newRates {
+		oldRate, exists := oldRates[protocol]
+		if exists && oldRate != newRate {
+			ls.exchangeRateChangedCallback(protocol, oldRate, newRate)
+		}
+	}
+}
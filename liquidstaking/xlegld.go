@@ -0,0 +1,130 @@
+package liquidstaking
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/stakingagency/sa-mx-sdk-go/data"
+	"github.com/stakingagency/sa-mx-sdk-go/network"
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+const XLegldProtocol = "xLEGLD"
+
+type XLegld struct {
+	contract
+
+	tokensMut       sync.Mutex
+	liquidToken     string
+	undelegateToken string
+}
+
+func NewXLegld(netMan *network.NetworkManager, contractAddress string) *XLegld {
+	return &XLegld{
+		contract: contract{
+			netMan:          netMan,
+			contractAddress: contractAddress,
+		},
+	}
+}
+
+func (x *XLegld) GetName() string {
+	return XLegldProtocol
+}
+
+func (x *XLegld) GetLiquidToken() (string, error) {
+	x.tokensMut.Lock()
+	defer x.tokensMut.Unlock()
+	if x.liquidToken != "" {
+		return x.liquidToken, nil
+	}
+
+	token, err := x.queryString("getLsTokenId")
+	if err != nil {
+		return "", err
+	}
+
+	x.liquidToken = token
+
+	return token, nil
+}
+
+func (x *XLegld) GetUndelegateToken() (string, error) {
+	x.tokensMut.Lock()
+	defer x.tokensMut.Unlock()
+	if x.undelegateToken != "" {
+		return x.undelegateToken, nil
+	}
+
+	token, err := x.queryString("getUnstakeTokenId")
+	if err != nil {
+		return "", err
+	}
+
+	x.undelegateToken = token
+
+	return token, nil
+}
+
+func (x *XLegld) GetExchangeRate() (float64, error) {
+	return x.queryEgld("getExchangeRate")
+}
+
+func (x *XLegld) GetTVL() (float64, error) {
+	return x.queryEgld("getTotalEgldStaked")
+}
+
+func (x *XLegld) GetUserPosition(address string) (*data.LiquidStakingPosition, error) {
+	return getUserPosition(x, &x.contract, address)
+}
+
+func (x *XLegld) GetUndelegations(address string) ([]*data.LiquidUndelegation, error) {
+	collection, err := x.GetUndelegateToken()
+	if err != nil {
+		return nil, err
+	}
+
+	// attributes: egld amount, unbond epoch
+	return x.getNftUndelegations(address, collection, func(attributes []byte) (*big.Int, uint64, bool) {
+		amount, idx, ok := utils.ParseBigInt(attributes, 0)
+		if !ok {
+			return nil, 0, false
+		}
+
+		unbondEpoch, _, ok := utils.ParseUint64(attributes, idx)
+
+		return amount, unbondEpoch, ok
+	})
+}
+
+func (x *XLegld) Delegate(pk []byte, amount float64) error {
+	return x.delegate(pk, amount)
+}
+
+func (x *XLegld) Undelegate(pk []byte, amount float64) error {
+	token, err := x.GetLiquidToken()
+	if err != nil {
+		return err
+	}
+
+	return x.undelegate(pk, token, amount)
+}
+
+func (x *XLegld) Withdraw(pk []byte) error {
+	address, err := network.GetAddressFromPrivateKey(pk)
+	if err != nil {
+		return err
+	}
+
+	undelegations, err := x.GetUndelegations(address)
+	if err != nil {
+		return err
+	}
+
+	collection, err := x.GetUndelegateToken()
+	if err != nil {
+		return err
+	}
+
+	return x.withdrawNfts(pk, undelegations, collection)
+}
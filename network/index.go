@@ -5,9 +5,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/big"
 	"strings"
 	"time"
 
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
 	"github.com/stakingagency/sa-mx-sdk-go/data"
 	"github.com/stakingagency/sa-mx-sdk-go/utils"
 )
@@ -129,6 +131,74 @@ func (nm *NetworkManager) GetTxLogs(hash string) ([]*data.IndexerEntry, error) {
 	return res, nil
 }
 
+func (nm *NetworkManager) GetTxEvents(hash string, identifier string) ([]*data.IndexerEvent, error) {
+	logs, err := nm.GetTxLogs(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*data.IndexerEvent, 0)
+	for _, log := range logs {
+		for _, event := range log.Source.Events {
+			if event.Identifier == identifier {
+				res = append(res, event)
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func (nm *NetworkManager) GetTxReceivedAmounts(hash string, address string) (map[string]*big.Int, error) {
+	conv, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	pubkey, err := conv.Decode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	logs, err := nm.GetTxLogs(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*data.IndexerEvent, 0)
+	for _, log := range logs {
+		events = append(events, log.Source.Events...)
+	}
+
+	return getReceivedAmounts(events, pubkey), nil
+}
+
+// transfer events have the topics [token1, nonce1, amount1, ... tokenN, nonceN, amountN, receiver]. A multi transfer
+// logs a single event for all its tokens. SFTs and MetaESDTs are keyed by their identifier, including the nonce
+func getReceivedAmounts(events []*data.IndexerEvent, receiver []byte) map[string]*big.Int {
+	res := make(map[string]*big.Int)
+	for _, event := range events {
+		if event.Identifier != "ESDTTransfer" && event.Identifier != "ESDTNFTTransfer" && event.Identifier != "MultiESDTNFTTransfer" {
+			continue
+		}
+
+		if len(event.Topics) < 4 || (len(event.Topics)-1)%3 != 0 || utils.Base64Decode(event.Topics[len(event.Topics)-1]) != string(receiver) {
+			continue
+		}
+
+		for i := 0; i+3 < len(event.Topics); i += 3 {
+			ticker := utils.Base64Decode(event.Topics[i])
+			nonce := big.NewInt(0).SetBytes([]byte(utils.Base64Decode(event.Topics[i+1]))).Uint64()
+			if nonce > 0 {
+				ticker = utils.GetNftIdentifier(ticker, nonce)
+			}
+			amount := big.NewInt(0).SetBytes([]byte(utils.Base64Decode(event.Topics[i+2])))
+			if res[ticker] == nil {
+				res[ticker] = big.NewInt(0)
+			}
+			res[ticker].Add(res[ticker], amount)
+		}
+	}
+
+	return res
+}
+
 func (nm *NetworkManager) GetTxOperations(hash string) ([]*data.IndexerEntry, error) {
 	query := make(map[string]map[string]string)
 	query["match"] = make(map[string]string)
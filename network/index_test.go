@@ -0,0 +1,102 @@
+package network
+
+import (
+	"encoding/base64"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/stakingagency/sa-mx-sdk-go/data"
+)
+
+// base64 encoded topics, as stored by the indexer
+const (
+	fixtureReceiver = "AAAAAAAAAAAFAM5+q3NpeM6Ukuu/ggbyUurLMzz6VIM="
+	fixtureOther    = "AAAAAAAAAAAFAPEt0QxNK+gmT+M52hS5+te982SufOs="
+	fixtureWegld    = "V0VHTEQtYmQ0ZDc5"
+	fixtureUsdc     = "VVNEQy1jNzZmMWY="
+	fixtureXmex     = "WE1FWC1mZGEzNTU="
+	fixtureNoNonce  = ""
+	fixtureNonce    = "Hw=="
+	fixture1e18     = "DeC2s6dkAAA="
+	fixture5e6      = "TEtA"
+	fixture2e6      = "HoSA"
+	fixture7        = "Bw=="
+)
+
+func TestGetReceivedAmounts(t *testing.T) {
+	oneEgld, _ := big.NewInt(0).SetString("1000000000000000000", 10)
+	tests := []struct {
+		name     string
+		events   []*data.IndexerEvent
+		expected map[string]*big.Int
+	}{
+		{
+			name: "single transfer",
+			events: []*data.IndexerEvent{
+				{Identifier: "ESDTTransfer", Topics: []string{fixtureWegld, fixtureNoNonce, fixture1e18, fixtureReceiver}},
+			},
+			expected: map[string]*big.Int{"WEGLD-bd4d79": oneEgld},
+		},
+		{
+			name: "single transfer to another address",
+			events: []*data.IndexerEvent{
+				{Identifier: "ESDTTransfer", Topics: []string{fixtureWegld, fixtureNoNonce, fixture1e18, fixtureOther}},
+			},
+			expected: map[string]*big.Int{},
+		},
+		{
+			name: "multi transfer of one token",
+			events: []*data.IndexerEvent{
+				{Identifier: "MultiESDTNFTTransfer", Topics: []string{fixtureUsdc, fixtureNoNonce, fixture5e6, fixtureReceiver}},
+			},
+			expected: map[string]*big.Int{"USDC-c76f1f": big.NewInt(5000000)},
+		},
+		{
+			name: "multi transfer of two tokens",
+			events: []*data.IndexerEvent{
+				{Identifier: "MultiESDTNFTTransfer", Topics: []string{fixtureWegld, fixtureNoNonce, fixture1e18, fixtureUsdc, fixtureNoNonce, fixture5e6, fixtureReceiver}},
+			},
+			expected: map[string]*big.Int{"WEGLD-bd4d79": oneEgld, "USDC-c76f1f": big.NewInt(5000000)},
+		},
+		{
+			name: "multi transfer of two tokens to another address",
+			events: []*data.IndexerEvent{
+				{Identifier: "MultiESDTNFTTransfer", Topics: []string{fixtureWegld, fixtureNoNonce, fixture1e18, fixtureReceiver, fixtureNoNonce, fixture5e6, fixtureOther}},
+			},
+			expected: map[string]*big.Int{},
+		},
+		{
+			name: "nft transfer",
+			events: []*data.IndexerEvent{
+				{Identifier: "ESDTNFTTransfer", Topics: []string{fixtureXmex, fixtureNonce, fixture7, fixtureReceiver}},
+			},
+			expected: map[string]*big.Int{"XMEX-fda355-1f": big.NewInt(7)},
+		},
+		{
+			name: "amounts of several events are added",
+			events: []*data.IndexerEvent{
+				{Identifier: "ESDTTransfer", Topics: []string{fixtureUsdc, fixtureNoNonce, fixture2e6, fixtureReceiver}},
+				{Identifier: "swap", Topics: []string{fixtureUsdc, fixtureNoNonce, fixture5e6, fixtureReceiver}},
+				{Identifier: "MultiESDTNFTTransfer", Topics: []string{fixtureUsdc, fixtureNoNonce, fixture5e6, fixtureXmex, fixtureNonce, fixture7, fixtureReceiver}},
+			},
+			expected: map[string]*big.Int{"USDC-c76f1f": big.NewInt(7000000), "XMEX-fda355-1f": big.NewInt(7)},
+		},
+		{
+			name: "malformed topics",
+			events: []*data.IndexerEvent{
+				{Identifier: "MultiESDTNFTTransfer", Topics: []string{fixtureUsdc, fixtureNoNonce, fixture5e6, fixtureWegld, fixtureReceiver}},
+				{Identifier: "ESDTTransfer", Topics: []string{fixtureUsdc, fixtureNoNonce, fixtureReceiver}},
+			},
+			expected: map[string]*big.Int{},
+		},
+	}
+
+	receiver, _ := base64.StdEncoding.DecodeString(fixtureReceiver)
+	for _, test := range tests {
+		res := getReceivedAmounts(test.events, receiver)
+		if !reflect.DeepEqual(res, test.expected) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, res)
+		}
+	}
+}
@@ -104,13 +104,18 @@ func (nm *NetworkManager) GetNetworkStatus() (*sdkData.NetworkStatus, error) {
 }
 
 func (nm *NetworkManager) QuerySC(scAddress, funcName string, args []string) (*sdkData.VmValuesResponseData, error) {
+	return nm.QueryScWithCaller(scAddress, "", funcName, args)
+}
+
+func (nm *NetworkManager) QueryScWithCaller(scAddress, caller, funcName string, args []string) (*sdkData.VmValuesResponseData, error) {
 	if args == nil {
 		args = make([]string, 0)
 	}
 	request := &sdkData.VmValueRequest{
-		Address:  scAddress,
-		FuncName: funcName,
-		Args:     args,
+		Address:    scAddress,
+		FuncName:   funcName,
+		CallerAddr: caller,
+		Args:       args,
 	}
 	res, err := nm.proxy.ExecuteVMQuery(context.Background(), request)
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"math/big"
 	"time"
 
 	"github.com/multiversx/mx-sdk-go/blockchain"
@@ -15,6 +16,15 @@ import (
 	"github.com/stakingagency/sa-mx-sdk-go/utils"
 )
 
+func GetAddressFromPrivateKey(privateKey []byte) (string, error) {
+	address, err := interactors.NewWallet().GetAddressFromPrivateKey(privateKey)
+	if err != nil {
+		return "", err
+	}
+
+	return address.AddressAsBech32String()
+}
+
 func (nm *NetworkManager) SendTransaction(privateKey []byte, receiver string, value float64, gasLimit uint64, dataField string, nonce uint64) (string, error) {
 	w := interactors.NewWallet()
 	sender, err := w.GetAddressFromPrivateKey(privateKey)
@@ -79,17 +89,70 @@ func (nm *NetworkManager) SendTransaction(privateKey []byte, receiver string, va
 	return hash, nil
 }
 
-func (nm *NetworkManager) SendEsdtTransaction(privateKey []byte, receiver string, value float64, gasLimit uint64, token *data.ESDT, function string, nonce uint64) (string, error) {
+// the ESDT transfer helpers take the raw function name and its already encoded arguments
+func (nm *NetworkManager) SendEsdtTransaction(privateKey []byte, receiver string, value float64, gasLimit uint64, token *data.ESDT, function string, args []string, nonce uint64) (string, error) {
 	iValue := utils.Renominate(value, int(token.Decimals))
 	sValue := hex.EncodeToString(iValue.Bytes())
 	sTicker := hex.EncodeToString([]byte(token.Ticker))
-	dataField := fmt.Sprintf("ESDTTransfer@%s@%s", sTicker, sValue)
-	if function != "" {
-		dataField += "@" + function
-	}
+	dataField := fmt.Sprintf("ESDTTransfer@%s@%s", sTicker, sValue) + functionCall(function, args)
 	if gasLimit == utils.AutoGasLimit {
 		gasLimit = 500000
 	}
 
 	return nm.SendTransaction(privateKey, receiver, 0, gasLimit, dataField, nonce)
 }
+
+func (nm *NetworkManager) SendEsdtNftTransaction(privateKey []byte, receiver string, collection string, tokenNonce uint64, quantity *big.Int, gasLimit uint64, function string, args []string, nonce uint64) (string, error) {
+	sender, err := GetAddressFromPrivateKey(privateKey)
+	if err != nil {
+		return "", err
+	}
+
+	sReceiver, err := utils.AddressArg(receiver)
+	if err != nil {
+		return "", err
+	}
+
+	dataField := fmt.Sprintf("ESDTNFTTransfer@%s@%s@%s@%s", utils.StringArg(collection), utils.Uint64Arg(tokenNonce), utils.BigIntArg(quantity), sReceiver) + functionCall(function, args)
+	if gasLimit == utils.AutoGasLimit {
+		gasLimit = 1000000
+	}
+
+	return nm.SendTransaction(privateKey, sender, 0, gasLimit, dataField, nonce)
+}
+
+func (nm *NetworkManager) SendMultiEsdtTransaction(privateKey []byte, receiver string, transfers []*data.EsdtTransfer, gasLimit uint64, function string, args []string, nonce uint64) (string, error) {
+	sender, err := GetAddressFromPrivateKey(privateKey)
+	if err != nil {
+		return "", err
+	}
+
+	sReceiver, err := utils.AddressArg(receiver)
+	if err != nil {
+		return "", err
+	}
+
+	dataField := fmt.Sprintf("MultiESDTNFTTransfer@%s@%s", sReceiver, utils.Uint64Arg(uint64(len(transfers))))
+	for _, transfer := range transfers {
+		dataField += fmt.Sprintf("@%s@%s@%s", utils.StringArg(transfer.Ticker), utils.Uint64Arg(transfer.Nonce), utils.BigIntArg(transfer.Amount))
+	}
+	dataField += functionCall(function, args)
+	if gasLimit == utils.AutoGasLimit {
+		gasLimit = 1000000 * uint64(len(transfers))
+	}
+
+	return nm.SendTransaction(privateKey, sender, 0, gasLimit, dataField, nonce)
+}
+
+func functionCall(function string, args []string) string {
+	if function == "" {
+		return ""
+	}
+
+	res := "@" + utils.StringArg(function)
+	for _, arg := range args {
+		res += "@" + arg
+	}
+
+	return res
+}
@@ -0,0 +1,185 @@
+package pricing
+
+import (
+	"math"
+
+	"github.com/stakingagency/sa-mx-sdk-go/data"
+	"github.com/stakingagency/sa-mx-sdk-go/exchanges/router"
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+// USD liquidity for which a price gets a 0.5 liquidity score
+const confidenceLiquidity = float64(50000)
+
+type pool struct {
+	key      string
+	token1   string
+	token2   string
+	reserve1 float64
+	reserve2 float64
+	lpToken  string
+	lpSupply float64
+}
+
+func (o *Oracle) getPools() []*pool {
+	pools := make([]*pool, 0)
+	for _, p := range router.GetActivePools(o.xex, o.one) {
+		pools = append(pools, &pool{
+			key:      p.GetKey(),
+			token1:   p.Token1.Ticker,
+			token2:   p.Token2.Ticker,
+			reserve1: utils.Denominate(p.Reserve1, int(p.Token1.Decimals)),
+			reserve2: utils.Denominate(p.Reserve2, int(p.Token2.Decimals)),
+			lpToken:  p.LpToken,
+			lpSupply: p.LpSupply,
+		})
+	}
+
+	return pools
+}
+
+// prices are propagated from the anchors, one hop at a time. A token's price is the average of the prices
+// implied by all its pools paired with an already priced token, weighted by the pools' USD liquidity
+func (o *Oracle) resolve(pools []*pool, anchors map[string]*data.TokenPrice, now int64) map[string]*data.TokenPrice {
+	prices := make(map[string]*data.TokenPrice)
+	for ticker, anchor := range anchors {
+		prices[ticker] = anchor
+	}
+
+	graph := make(map[string][]*pool)
+	for _, p := range pools {
+		graph[p.token1] = append(graph[p.token1], p)
+		graph[p.token2] = append(graph[p.token2], p)
+	}
+
+	for hop := 1; hop <= o.maxHops; hop++ {
+		newPrices := make(map[string]*data.TokenPrice)
+		for ticker, tokenPools := range graph {
+			if prices[ticker] != nil {
+				continue
+			}
+
+			price := o.resolveToken(ticker, tokenPools, prices, now)
+			if price != nil {
+				price.Hops = hop
+				newPrices[ticker] = price
+			}
+		}
+		if len(newPrices) == 0 {
+			break
+		}
+
+		for ticker, price := range newPrices {
+			prices[ticker] = price
+		}
+	}
+
+	for _, p := range pools {
+		price1 := prices[p.token1]
+		price2 := prices[p.token2]
+		if price1 == nil || price2 == nil {
+			continue
+		}
+
+		tvl := p.reserve1*price1.Price + p.reserve2*price2.Price
+		if price1.Hops == 0 {
+			price1.Liquidity += tvl
+		}
+		if price2.Hops == 0 {
+			price2.Liquidity += tvl
+		}
+		if p.lpToken == "" || p.lpSupply <= 0 || prices[p.lpToken] != nil {
+			continue
+		}
+
+		prices[p.lpToken] = &data.TokenPrice{
+			Ticker:     p.lpToken,
+			Price:      tvl / p.lpSupply,
+			Liquidity:  tvl,
+			Confidence: math.Min(price1.Confidence, price2.Confidence),
+			Hops:       maxInt(price1.Hops, price2.Hops) + 1,
+			Sources:    []string{p.key},
+			Timestamp:  minInt64(price1.Timestamp, price2.Timestamp),
+		}
+	}
+
+	return prices
+}
+
+func (o *Oracle) resolveToken(ticker string, tokenPools []*pool, prices map[string]*data.TokenPrice, now int64) *data.TokenPrice {
+	candidates := make([]float64, 0)
+	weights := make([]float64, 0)
+	sumWeights := float64(0)
+	sumConfidence := float64(0)
+	liquidity := float64(0)
+	timestamp := now
+	sources := make([]string, 0)
+	for _, p := range tokenPools {
+		other, reserve, otherReserve := p.token2, p.reserve1, p.reserve2
+		if p.token2 == ticker {
+			other, reserve, otherReserve = p.token1, p.reserve2, p.reserve1
+		}
+		otherPrice := prices[other]
+		if otherPrice == nil || reserve <= 0 || otherReserve <= 0 {
+			continue
+		}
+
+		poolLiquidity := otherReserve * otherPrice.Price
+		if poolLiquidity < o.minLiquidity {
+			continue
+		}
+
+		weight := poolLiquidity * otherPrice.Confidence
+		candidates = append(candidates, otherReserve/reserve*otherPrice.Price)
+		weights = append(weights, weight)
+		sumWeights += weight
+		sumConfidence += otherPrice.Confidence * weight
+		liquidity += 2 * poolLiquidity
+		timestamp = minInt64(timestamp, otherPrice.Timestamp)
+		sources = append(sources, p.key)
+	}
+	if sumWeights == 0 {
+		return nil
+	}
+
+	price := float64(0)
+	for i, candidate := range candidates {
+		price += candidate * weights[i]
+	}
+	price /= sumWeights
+
+	variance := float64(0)
+	for i, candidate := range candidates {
+		variance += (candidate - price) * (candidate - price) * weights[i]
+	}
+	dispersion := math.Sqrt(variance/sumWeights) / price
+
+	confidence := sumConfidence / sumWeights
+	confidence *= liquidity / (liquidity + confidenceLiquidity)
+	confidence *= math.Max(0, 1-dispersion)
+
+	return &data.TokenPrice{
+		Ticker:     ticker,
+		Price:      price,
+		Liquidity:  liquidity,
+		Confidence: confidence,
+		Sources:    sources,
+		Timestamp:  timestamp,
+	}
+}
+
+func minInt64(a int64, b int64) int64 {
+	if a < b {
+		return a
+	}
+
+	return b
+}
+
+func maxInt(a int, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,236 @@
+package pricing
+
+import (
+	"sync"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/stakingagency/sa-mx-sdk-go/data"
+	"github.com/stakingagency/sa-mx-sdk-go/exchanges/onedex"
+	"github.com/stakingagency/sa-mx-sdk-go/exchanges/xexchange"
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+const (
+	defaultMaxHops      = 3
+	defaultMinLiquidity = float64(1000)
+	defaultMaxAge       = 5 * time.Minute
+)
+
+type Oracle struct {
+	xex             *xexchange.XExchange
+	one             *onedex.OneDex
+	refreshInterval time.Duration
+
+	sources      []Source
+	stablecoins  map[string]bool
+	maxHops      int
+	minLiquidity float64
+	maxAge       time.Duration
+
+	references map[string]*data.TokenPrice
+	computeMut sync.Mutex
+
+	cachedPrices    map[string]*data.TokenPrice
+	cachedPricesMut sync.Mutex
+}
+
+var log = logger.GetOrCreate("pricing")
+
+func NewOracle(xex *xexchange.XExchange, one *onedex.OneDex, refreshInterval time.Duration) (*Oracle, error) {
+	maxAge := defaultMaxAge
+	if refreshInterval != utils.NoRefresh {
+		maxAge = 3 * refreshInterval
+	}
+
+	o := &Oracle{
+		xex:             xex,
+		one:             one,
+		refreshInterval: refreshInterval,
+
+		sources: make([]Source, 0),
+		stablecoins: map[string]bool{
+			utils.USDC: true,
+			utils.USDT: true,
+			utils.BUSD: true,
+		},
+		maxHops:      defaultMaxHops,
+		minLiquidity: defaultMinLiquidity,
+		maxAge:       maxAge,
+
+		references: make(map[string]*data.TokenPrice),
+
+		cachedPrices: make(map[string]*data.TokenPrice),
+	}
+	o.startTasks()
+
+	return o, nil
+}
+
+func (o *Oracle) AddSource(source Source) {
+	o.computeMut.Lock()
+	o.sources = append(o.sources, source)
+	o.computeMut.Unlock()
+}
+
+func (o *Oracle) AddStablecoin(ticker string) {
+	o.computeMut.Lock()
+	o.stablecoins[ticker] = true
+	o.computeMut.Unlock()
+}
+
+func (o *Oracle) RemoveStablecoin(ticker string) {
+	o.computeMut.Lock()
+	delete(o.stablecoins, ticker)
+	o.computeMut.Unlock()
+}
+
+func (o *Oracle) SetMaxHops(maxHops int) {
+	o.maxHops = maxHops
+}
+
+func (o *Oracle) SetMinLiquidity(minLiquidity float64) {
+	o.minLiquidity = minLiquidity
+}
+
+func (o *Oracle) SetMaxAge(maxAge time.Duration) {
+	o.maxAge = maxAge
+}
+
+func (o *Oracle) GetPrices() (map[string]*data.TokenPrice, error) {
+	prices, err := o.computePrices()
+	if err != nil {
+		return nil, err
+	}
+
+	return o.copyPrices(prices), nil
+}
+
+func (o *Oracle) GetPrice(ticker string) (*data.TokenPrice, error) {
+	prices, err := o.GetPrices()
+	if err != nil {
+		return nil, err
+	}
+
+	price, ok := prices[ticker]
+	if !ok {
+		return nil, utils.ErrPriceNotFound
+	}
+
+	return price, nil
+}
+
+func (o *Oracle) GetCachedPrices() (map[string]*data.TokenPrice, error) {
+	if o.refreshInterval == utils.NoRefresh {
+		return nil, utils.ErrRefreshIntervalNotSet
+	}
+
+	o.cachedPricesMut.Lock()
+	prices := o.cachedPrices
+	o.cachedPricesMut.Unlock()
+
+	return o.copyPrices(prices), nil
+}
+
+func (o *Oracle) GetCachedPrice(ticker string) (*data.TokenPrice, error) {
+	prices, err := o.GetCachedPrices()
+	if err != nil {
+		return nil, err
+	}
+
+	price, ok := prices[ticker]
+	if !ok {
+		return nil, utils.ErrPriceNotFound
+	}
+
+	return price, nil
+}
+
+func (o *Oracle) GetCachedUsdValue(ticker string, amount float64) (float64, error) {
+	price, err := o.GetCachedPrice(ticker)
+	if err != nil {
+		return 0, err
+	}
+
+	return price.Price * amount, nil
+}
+
+func (o *Oracle) copyPrices(prices map[string]*data.TokenPrice) map[string]*data.TokenPrice {
+	now := time.Now().Unix()
+	res := make(map[string]*data.TokenPrice, len(prices))
+	for ticker, price := range prices {
+		p := *price
+		p.Stale = now-p.Timestamp > int64(o.maxAge.Seconds())
+		res[ticker] = &p
+	}
+
+	return res
+}
+
+func (o *Oracle) computePrices() (map[string]*data.TokenPrice, error) {
+	o.computeMut.Lock()
+	defer o.computeMut.Unlock()
+
+	pools := o.getPools()
+	if len(pools) == 0 {
+		return nil, utils.ErrPriceNotFound
+	}
+
+	now := time.Now().Unix()
+	anchors := o.getAnchors(now)
+
+	return o.resolve(pools, anchors, now), nil
+}
+
+func (o *Oracle) getAnchors(now int64) map[string]*data.TokenPrice {
+	anchors := make(map[string]*data.TokenPrice)
+	for ticker := range o.stablecoins {
+		anchors[ticker] = &data.TokenPrice{
+			Ticker:     ticker,
+			Price:      1,
+			Confidence: 1,
+			Sources:    []string{"peg"},
+			Timestamp:  now,
+		}
+	}
+
+	for _, source := range o.sources {
+		for _, ticker := range source.GetTickers() {
+			key := source.GetName() + ":" + ticker
+			price, err := source.GetPrice(ticker)
+			if err != nil {
+				log.Warn("get reference price", "error", err, "source", source.GetName(), "ticker", ticker, "function", "getAnchors")
+			} else {
+				o.references[key] = &data.TokenPrice{
+					Ticker:     ticker,
+					Price:      price,
+					Confidence: 1,
+					Sources:    []string{source.GetName()},
+					Timestamp:  now,
+				}
+			}
+
+			// on error, the last known reference price is used until it becomes stale
+			reference := o.references[key]
+			if reference == nil {
+				continue
+			}
+
+			anchor := anchors[ticker]
+			if anchor == nil || anchor.Sources[0] == "peg" {
+				p := *reference
+				anchors[ticker] = &p
+				continue
+			}
+
+			n := float64(len(anchor.Sources))
+			anchor.Price = (anchor.Price*n + reference.Price) / (n + 1)
+			anchor.Sources = append(anchor.Sources, reference.Sources...)
+			if reference.Timestamp < anchor.Timestamp {
+				anchor.Timestamp = reference.Timestamp
+			}
+		}
+	}
+
+	return anchors
+}
@@ -0,0 +1,59 @@
+package pricing
+
+import (
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+type Source interface {
+	GetName() string
+	GetTickers() []string
+	GetPrice(ticker string) (float64, error)
+}
+
+type BinanceSource struct {
+	symbols map[string]string
+}
+
+func NewBinanceSource() *BinanceSource {
+	return &BinanceSource{
+		symbols: map[string]string{
+			utils.WEGLD: "EGLD",
+			utils.USDC:  "USDC",
+		},
+	}
+}
+
+func (bs *BinanceSource) AddSymbol(ticker string, symbol string) {
+	bs.symbols[ticker] = symbol
+}
+
+func (bs *BinanceSource) GetName() string {
+	return "binance"
+}
+
+func (bs *BinanceSource) GetTickers() []string {
+	res := make([]string, 0, len(bs.symbols))
+	for ticker := range bs.symbols {
+		res = append(res, ticker)
+	}
+
+	return res
+}
+
+func (bs *BinanceSource) GetPrice(ticker string) (float64, error) {
+	symbol, ok := bs.symbols[ticker]
+	if !ok {
+		return 0, utils.ErrPriceNotFound
+	}
+
+	price, err := utils.QueryBinancePrice(symbol)
+	if err != nil {
+		return 0, err
+	}
+
+	if price <= 0 {
+		return 0, utils.ErrPriceNotFound
+	}
+
+	return price, nil
+}
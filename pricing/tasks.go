@@ -0,0 +1,39 @@
+package pricing
+
+import (
+	"time"
+
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+func (o *Oracle) startTasks() {
+	if o.refreshInterval == utils.NoRefresh {
+		return
+	}
+
+	go func() {
+		for {
+			startTime := time.Now().UnixNano()
+
+			o.refreshPrices()
+
+			endTime := time.Now().UnixNano()
+			waitTime := o.refreshInterval - time.Duration(endTime-startTime)
+			if waitTime > 0 {
+				time.Sleep(waitTime)
+			}
+		}
+	}()
+}
+
+func (o *Oracle) refreshPrices() {
+	prices, err := o.computePrices()
+	if err != nil {
+		log.Error("compute prices", "error", err, "function", "refreshPrices")
+		return
+	}
+
+	o.cachedPricesMut.Lock()
+	o.cachedPrices = prices
+	o.cachedPricesMut.Unlock()
+}
@@ -0,0 +1,144 @@
+package staking
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"sort"
+
+	sdkData "github.com/multiversx/mx-sdk-go/data"
+	"github.com/stakingagency/sa-mx-sdk-go/data"
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+const (
+	nodePrice                     = float64(2500)
+	protocolSustainabilityPercent = 0.1
+	topUpFactor                   = 0.25
+	topUpGradientPoint            = float64(3000000)
+	daysPerYear                   = 365
+)
+
+func (st *Staking) GetNetworkEconomics() (*data.NetworkEconomics, error) {
+	response := &sdkData.NetworkEconomicsResponse{}
+	err := st.netMan.QueryProxy("network/economics", response)
+	if err != nil {
+		log.Error("query proxy", "error", err, "function", "GetNetworkEconomics")
+		return nil, err
+	}
+
+	if response.Error != "" {
+		return nil, errors.New(response.Error)
+	}
+
+	economics := response.Data.Economics
+	if economics == nil {
+		return nil, utils.ErrInvalidResponse
+	}
+
+	netCfg := st.netMan.GetNetworkConfig()
+	epochDuration := float64(netCfg.RoundDuration) * float64(netCfg.RoundsPerEpoch) / 1000
+	if epochDuration == 0 {
+		return nil, utils.ErrInvalidResponse
+	}
+
+	res := &data.NetworkEconomics{
+		TotalSupply:    denominateString(economics.TotalSupply),
+		TotalStaked:    denominateString(economics.TotalStakedValue),
+		TotalTopUp:     denominateString(economics.TotalTopUpValue),
+		EpochInflation: denominateString(economics.Inflation),
+		EpochsPerYear:  daysPerYear * 24 * 3600 / epochDuration,
+	}
+	res.TotalBaseStake = res.TotalStaked - res.TotalTopUp
+	res.YearlyRewards = res.EpochInflation * res.EpochsPerYear
+	res.SustainabilityFund = res.YearlyRewards * protocolSustainabilityPercent
+	validatorsRewards := res.YearlyRewards - res.SustainabilityFund
+	res.TopUpRewards = 2 * topUpFactor * validatorsRewards / math.Pi * math.Atan(res.TotalTopUp/topUpGradientPoint)
+	res.BaseRewards = validatorsRewards - res.TopUpRewards
+
+	return res, nil
+}
+
+func (st *Staking) GetProviderAPR(providerAddress string) (*data.ProviderAPR, error) {
+	economics, err := st.GetNetworkEconomics()
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := st.GetProviderConfig(providerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return st.getProviderAPR(economics, cfg)
+}
+
+func (st *Staking) GetProvidersAPR() ([]*data.ProviderAPR, error) {
+	economics, err := st.GetNetworkEconomics()
+	if err != nil {
+		return nil, err
+	}
+
+	configs, err := st.GetProvidersConfigs()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*data.ProviderAPR, 0, len(configs))
+	for address, cfg := range configs {
+		apr, err := st.getProviderAPR(economics, cfg)
+		if err != nil {
+			log.Warn("get provider apr", "error", err, "provider", address, "function", "GetProvidersAPR")
+			continue
+		}
+
+		res = append(res, apr)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].NetAPR > res[j].NetAPR
+	})
+
+	return res, nil
+}
+
+func (st *Staking) getProviderAPR(economics *data.NetworkEconomics, cfg *data.StakingProvider) (*data.ProviderAPR, error) {
+	topUp, totalStaked, stakedNodes, err := st.GetProviderTopUp(cfg.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &data.ProviderAPR{
+		ContractAddress: cfg.ContractAddress,
+		Name:            cfg.Name,
+		ServiceFee:      cfg.ServiceFee,
+		StakedNodes:     stakedNodes,
+		TotalStake:      totalStaked,
+		TopUp:           topUp,
+	}
+	if stakedNodes == 0 || totalStaked == 0 {
+		return res, nil
+	}
+
+	res.TopUpPerNode = topUp / float64(stakedNodes)
+	rewards := float64(0)
+	if economics.TotalBaseStake > 0 {
+		rewards += economics.BaseRewards * float64(stakedNodes) * nodePrice / economics.TotalBaseStake
+	}
+	if economics.TotalTopUp > 0 {
+		rewards += economics.TopUpRewards * topUp / economics.TotalTopUp
+	}
+	res.GrossAPR = rewards * 100 / totalStaked
+	res.NetAPR = res.GrossAPR * (100 - cfg.ServiceFee) / 100
+	res.NetAPY = (math.Pow(1+res.NetAPR/100/economics.EpochsPerYear, economics.EpochsPerYear) - 1) * 100
+
+	return res, nil
+}
+
+func denominateString(value string) float64 {
+	iValue, ok := big.NewInt(0).SetString(value, 10)
+	if !ok {
+		return 0
+	}
+
+	return utils.Denominate(iValue, 18)
+}
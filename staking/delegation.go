@@ -0,0 +1,231 @@
+package staking
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/stakingagency/sa-mx-sdk-go/data"
+	"github.com/stakingagency/sa-mx-sdk-go/network"
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+const (
+	delegateGasLimit   = uint64(12000000)
+	undelegateGasLimit = uint64(12000000)
+	withdrawGasLimit   = uint64(12000000)
+	claimGasLimit      = uint64(6000000)
+	redelegateGasLimit = uint64(12000000)
+)
+
+type delegationManagerConfig struct {
+	minServiceFee       float64
+	maxServiceFee       float64
+	minDeposit          float64
+	minDelegationAmount float64
+}
+
+// getContractConfig can only be called by the delegation manager itself
+func (st *Staking) getDelegationManagerConfig() (*delegationManagerConfig, error) {
+	res, err := st.netMan.QueryScWithCaller(utils.DelegationManagerSC, utils.DelegationManagerSC, "getContractConfig", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Data.ReturnMessage != "" {
+		return nil, errors.New(res.Data.ReturnMessage)
+	}
+
+	if len(res.Data.ReturnData) < 6 {
+		return nil, utils.ErrInvalidResponse
+	}
+
+	ints := make([]*big.Int, 0)
+	for _, b := range res.Data.ReturnData {
+		ints = append(ints, big.NewInt(0).SetBytes(b))
+	}
+
+	return &delegationManagerConfig{
+		minServiceFee:       utils.Denominate(ints[2], 2),
+		maxServiceFee:       utils.Denominate(ints[3], 2),
+		minDeposit:          utils.Denominate(ints[4], 18),
+		minDelegationAmount: utils.Denominate(ints[5], 18),
+	}, nil
+}
+
+func (st *Staking) GetMinDelegationAmount() (float64, error) {
+	cfg, err := st.getDelegationManagerConfig()
+	if err != nil {
+		return 0, err
+	}
+
+	return cfg.minDelegationAmount, nil
+}
+
+func (st *Staking) Delegate(pk []byte, providerAddress string, amount float64) (*data.DelegationResult, error) {
+	minDelegation, err := st.GetMinDelegationAmount()
+	if err != nil {
+		return nil, err
+	}
+
+	if amount < minDelegation {
+		return nil, utils.ErrBelowMinDelegation
+	}
+
+	cfg, err := st.GetProviderConfig(providerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.HasDelegationCap && cfg.ActiveStake+amount > cfg.MaxDelegationCap {
+		return nil, utils.ErrDelegationCapReached
+	}
+
+	return st.sendDelegationTx(pk, providerAddress, amount, delegateGasLimit, "delegate")
+}
+
+func (st *Staking) Undelegate(pk []byte, providerAddress string, amount float64) (*data.DelegationResult, error) {
+	minDelegation, err := st.GetMinDelegationAmount()
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := network.GetAddressFromPrivateKey(pk)
+	if err != nil {
+		return nil, err
+	}
+
+	iStake, _, _, _, err := st.GetUserStakeInfo(address, providerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	stake := utils.Denominate(iStake, 18)
+	if amount > stake {
+		return nil, utils.ErrInsufficientStake
+	}
+
+	remaining := stake - amount
+	if amount < minDelegation && remaining > 0 {
+		return nil, utils.ErrBelowMinDelegation
+	}
+
+	if remaining > 0 && remaining < minDelegation {
+		return nil, utils.ErrBelowMinDelegation
+	}
+
+	iAmount := utils.Renominate(amount, 18)
+	if amount == stake {
+		iAmount = iStake
+	}
+	dataField := "unDelegate@" + utils.BigIntArg(iAmount)
+
+	return st.sendDelegationTx(pk, providerAddress, 0, undelegateGasLimit, dataField)
+}
+
+func (st *Staking) Withdraw(pk []byte, providerAddress string) (*data.DelegationResult, error) {
+	address, err := network.GetAddressFromPrivateKey(pk)
+	if err != nil {
+		return nil, err
+	}
+
+	_, _, _, unbondable, err := st.GetUserStakeInfo(address, providerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if unbondable.Cmp(big.NewInt(0)) == 0 {
+		return nil, utils.ErrNothingToWithdraw
+	}
+
+	return st.sendDelegationTx(pk, providerAddress, 0, withdrawGasLimit, "withdraw")
+}
+
+func (st *Staking) ClaimRewards(pk []byte, providerAddress string) (*data.DelegationResult, error) {
+	err := st.checkRewards(pk, providerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return st.sendDelegationTx(pk, providerAddress, 0, claimGasLimit, "claimRewards")
+}
+
+func (st *Staking) RedelegateRewards(pk []byte, providerAddress string) (*data.DelegationResult, error) {
+	err := st.checkRewards(pk, providerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := st.GetProviderConfig(providerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.HasDelegationCap && cfg.CheckCapOnRedelegate && cfg.ActiveStake >= cfg.MaxDelegationCap {
+		return nil, utils.ErrDelegationCapReached
+	}
+
+	return st.sendDelegationTx(pk, providerAddress, 0, redelegateGasLimit, "reDelegateRewards")
+}
+
+func (st *Staking) checkRewards(pk []byte, providerAddress string) error {
+	address, err := network.GetAddressFromPrivateKey(pk)
+	if err != nil {
+		return err
+	}
+
+	_, reward, _, _, err := st.GetUserStakeInfo(address, providerAddress)
+	if err != nil {
+		return err
+	}
+
+	if reward.Cmp(big.NewInt(0)) == 0 {
+		return utils.ErrNothingToClaim
+	}
+
+	return nil
+}
+
+func (st *Staking) sendDelegationTx(pk []byte, providerAddress string, value float64, gasLimit uint64, dataField string) (*data.DelegationResult, error) {
+	hash, err := st.netMan.SendTransaction(pk, providerAddress, value, gasLimit, dataField, utils.AutoNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	err = st.netMan.GetTxResult(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	operation := strings.Split(dataField, "@")[0]
+	result := &data.DelegationResult{
+		TxHash:    hash,
+		Operation: operation,
+	}
+
+	result.Amount, err = st.getOperationAmount(hash, operation)
+	if err != nil {
+		return nil, err
+	}
+
+	if operation == "claimRewards" || operation == "reDelegateRewards" {
+		result.Rewards = result.Amount
+	}
+
+	return result, nil
+}
+
+func (st *Staking) getOperationAmount(hash string, operation string) (float64, error) {
+	events, err := st.netMan.GetTxEvents(hash, operation)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(events) == 0 || len(events[0].Topics) == 0 {
+		return 0, utils.ErrEventNotFound
+	}
+
+	iAmount := big.NewInt(0).SetBytes([]byte(utils.Base64Decode(events[0].Topics[0])))
+
+	return utils.Denominate(iAmount, 18), nil
+}
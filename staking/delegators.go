@@ -0,0 +1,160 @@
+package staking
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/stakingagency/sa-mx-sdk-go/data"
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+const defaultLargeDelegationThreshold = float64(10000)
+
+func (st *Staking) GetDelegators(providerAddress string) ([]*data.Delegator, error) {
+	query := make(map[string]map[string]string)
+	query["match"] = make(map[string]string)
+	query["match"]["contract"] = providerAddress
+	entries, err := st.searchDelegators(query)
+	if err != nil {
+		log.Error("search delegators", "error", err, "provider", providerAddress, "function", "GetDelegators")
+		return nil, err
+	}
+
+	res := make([]*data.Delegator, 0, len(entries))
+	for _, entry := range entries {
+		delegator := newDelegator(entry)
+		if delegator.ActiveStake == 0 {
+			continue
+		}
+
+		res = append(res, delegator)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].ActiveStake > res[j].ActiveStake
+	})
+
+	return res, nil
+}
+
+func (st *Staking) GetDelegatorsDistribution(providerAddress string, topHolders int) (*data.DelegatorsDistribution, error) {
+	delegators, err := st.GetDelegators(providerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return ComputeDelegatorsDistribution(providerAddress, delegators, topHolders), nil
+}
+
+func ComputeDelegatorsDistribution(providerAddress string, delegators []*data.Delegator, topHolders int) *data.DelegatorsDistribution {
+	sorted := make([]*data.Delegator, len(delegators))
+	copy(sorted, delegators)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].ActiveStake > sorted[j].ActiveStake
+	})
+
+	res := &data.DelegatorsDistribution{
+		ContractAddress: providerAddress,
+		DelegatorsCount: len(sorted),
+		TopHolders:      make([]*data.Delegator, 0),
+	}
+	if len(sorted) == 0 {
+		return res
+	}
+
+	for _, delegator := range sorted {
+		res.TotalStake += delegator.ActiveStake
+	}
+	res.AverageStake = res.TotalStake / float64(len(sorted))
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		res.MedianStake = (sorted[mid-1].ActiveStake + sorted[mid].ActiveStake) / 2
+	} else {
+		res.MedianStake = sorted[mid].ActiveStake
+	}
+	if topHolders > len(sorted) {
+		topHolders = len(sorted)
+	}
+	if topHolders > 0 {
+		res.TopHolders = sorted[:topHolders]
+	}
+	if res.TotalStake == 0 {
+		return res
+	}
+
+	res.TopHoldersShare = stakeShare(sorted, topHolders, res.TotalStake)
+	res.Top10Share = stakeShare(sorted, 10, res.TotalStake)
+	res.Top100Share = stakeShare(sorted, 100, res.TotalStake)
+
+	cumulated := float64(0)
+	for i, delegator := range sorted {
+		cumulated += delegator.ActiveStake
+		if cumulated > res.TotalStake/2 {
+			res.NakamotoIndex = i + 1
+			break
+		}
+	}
+
+	// gini coefficient, computed on the ascending ordered stakes
+	n := float64(len(sorted))
+	weighted := float64(0)
+	for i := range sorted {
+		weighted += float64(i+1) * sorted[len(sorted)-1-i].ActiveStake
+	}
+	res.Gini = (2*weighted)/(n*res.TotalStake) - (n+1)/n
+
+	return res
+}
+
+func (st *Staking) searchDelegators(query interface{}) ([]*data.IndexerEntry, error) {
+	sortBy := []interface{}{
+		map[string]string{"activeStakeNum": "desc"},
+	}
+
+	return st.netMan.SearchIndexer("delegators", query, sortBy)
+}
+
+func (st *Staking) getAllDelegators() (map[string]map[string]float64, error) {
+	query := make(map[string]map[string]interface{})
+	query["match_all"] = make(map[string]interface{})
+	entries, err := st.searchDelegators(query)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]map[string]float64)
+	for _, entry := range entries {
+		delegator := newDelegator(entry)
+		if res[entry.Source.Contract] == nil {
+			res[entry.Source.Contract] = make(map[string]float64)
+		}
+		res[entry.Source.Contract][delegator.Address] = delegator.ActiveStake
+	}
+
+	return res, nil
+}
+
+func newDelegator(entry *data.IndexerEntry) *data.Delegator {
+	delegator := &data.Delegator{
+		Address:     entry.Source.Address,
+		ActiveStake: entry.Source.ActiveStakeNum,
+	}
+	iStake, ok := big.NewInt(0).SetString(entry.Source.ActiveStake, 10)
+	if ok {
+		delegator.ActiveStake = utils.Denominate(iStake, 18)
+	}
+
+	return delegator
+}
+
+func stakeShare(sorted []*data.Delegator, count int, totalStake float64) float64 {
+	if count > len(sorted) {
+		count = len(sorted)
+	}
+
+	stake := float64(0)
+	for _, delegator := range sorted[:count] {
+		stake += delegator.ActiveStake
+	}
+
+	return stake * 100 / totalStake
+}
@@ -0,0 +1,183 @@
+package staking
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+
+	"github.com/stakingagency/sa-mx-sdk-go/data"
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+const (
+	NodeStatusStaked    = "staked"
+	NodeStatusNotStaked = "notStaked"
+	NodeStatusUnStaked  = "unStaked"
+	NodeStatusJailed    = "jailed"
+	NodeStatusQueued    = "queued"
+	NodeStatusEligible  = "eligible"
+	NodeStatusWaiting   = "waiting"
+)
+
+func (st *Staking) GetValidatorStatistics() (map[string]*data.ValidatorStatistics, error) {
+	response := &data.ValidatorStatisticsResponse{}
+	err := st.netMan.QueryProxy("validator/statistics", response)
+	if err != nil {
+		log.Error("query proxy", "error", err, "function", "GetValidatorStatistics")
+		return nil, err
+	}
+
+	if response.Error != "" {
+		return nil, errors.New(response.Error)
+	}
+
+	return response.Data.Statistics, nil
+}
+
+func (st *Staking) GetProviderTopUp(providerAddress string) (topUp float64, totalStaked float64, stakedNodes int, err error) {
+	sAddress, err := utils.AddressArg(providerAddress)
+	if err != nil {
+		return
+	}
+
+	res, err := st.netMan.QueryScWithCaller(utils.StakingSC, utils.StakingSC, "getTotalStakedTopUpStakedBlsKeys", []string{sAddress})
+	if err != nil {
+		log.Error("query vm", "error", err, "function", "GetProviderTopUp")
+		return
+	}
+
+	if len(res.Data.ReturnData) < 3 {
+		err = utils.ErrInvalidResponse
+		return
+	}
+
+	topUp = utils.Denominate(big.NewInt(0).SetBytes(res.Data.ReturnData[0]), 18)
+	totalStaked = utils.Denominate(big.NewInt(0).SetBytes(res.Data.ReturnData[1]), 18)
+	stakedNodes = int(big.NewInt(0).SetBytes(res.Data.ReturnData[2]).Int64())
+
+	return
+}
+
+func (st *Staking) GetProviderNodes(providerAddress string) ([]*data.ProviderNode, error) {
+	stats, err := st.GetValidatorStatistics()
+	if err != nil {
+		return nil, err
+	}
+
+	return st.getProviderNodes(providerAddress, stats)
+}
+
+func (st *Staking) GetCachedProviderNodes(providerAddress string) ([]*data.ProviderNode, error) {
+	if st.refreshInterval == utils.NoRefresh {
+		return nil, utils.ErrRefreshIntervalNotSet
+	}
+
+	st.cachedNodesMut.Lock()
+	nodes := st.cachedNodes[providerAddress]
+	st.cachedNodesMut.Unlock()
+	if nodes == nil {
+		return st.GetProviderNodes(providerAddress)
+	}
+
+	res := make([]*data.ProviderNode, 0, len(nodes))
+	for _, node := range nodes {
+		res = append(res, node)
+	}
+
+	return res, nil
+}
+
+func (st *Staking) getProviderNodes(providerAddress string, stats map[string]*data.ValidatorStatistics) ([]*data.ProviderNode, error) {
+	res, err := st.netMan.QuerySC(providerAddress, "getAllNodeStates", nil)
+	if err != nil {
+		log.Error("query vm", "error", err, "function", "getProviderNodes")
+		return nil, err
+	}
+
+	nodes := parseNodeStates(res.Data.ReturnData)
+
+	sAddress, err := utils.AddressArg(providerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err = st.netMan.QueryScWithCaller(utils.StakingSC, utils.StakingSC, "getBlsKeysStatus", []string{sAddress})
+	if err != nil {
+		log.Error("query vm", "error", err, "function", "getProviderNodes")
+		return nil, err
+	}
+
+	for i := 0; i+1 < len(res.Data.ReturnData); i += 2 {
+		blsKey := hex.EncodeToString(res.Data.ReturnData[i])
+		node := nodes[blsKey]
+		if node == nil {
+			node = &data.ProviderNode{
+				BlsKey: blsKey,
+			}
+			nodes[blsKey] = node
+		}
+		node.StakingStatus = string(res.Data.ReturnData[i+1])
+	}
+
+	topUp, _, stakedNodes, err := st.GetProviderTopUp(providerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*data.ProviderNode, 0, len(nodes))
+	for blsKey, node := range nodes {
+		stat := stats[blsKey]
+		if stat != nil {
+			node.ValidatorStatus = stat.ValidatorStatus
+			node.ShardID = stat.ShardID
+			node.Rating = stat.Rating
+			node.TempRating = stat.TempRating
+		}
+		if node.StakingStatus == NodeStatusStaked && stakedNodes > 0 {
+			node.TopUp = topUp / float64(stakedNodes)
+		}
+		node.Status = nodeStatus(node)
+		result = append(result, node)
+	}
+
+	return result, nil
+}
+
+// getAllNodeStates returns each state label followed by the BLS keys in that state
+func parseNodeStates(returnData [][]byte) map[string]*data.ProviderNode {
+	nodes := make(map[string]*data.ProviderNode)
+	state := ""
+	for _, item := range returnData {
+		if len(item) != blsKeyLength {
+			state = string(item)
+			continue
+		}
+
+		blsKey := hex.EncodeToString(item)
+		nodes[blsKey] = &data.ProviderNode{
+			BlsKey:          blsKey,
+			DelegationState: state,
+		}
+	}
+
+	return nodes
+}
+
+func nodeStatus(node *data.ProviderNode) string {
+	switch node.StakingStatus {
+	case NodeStatusJailed, NodeStatusQueued:
+		return node.StakingStatus
+	case NodeStatusStaked:
+		if node.ValidatorStatus != "" {
+			return node.ValidatorStatus
+		}
+
+		return NodeStatusStaked
+	}
+
+	if node.DelegationState != "" {
+		return node.DelegationState
+	}
+
+	return NodeStatusNotStaked
+}
@@ -0,0 +1,50 @@
+package staking
+
+import (
+	"encoding/base64"
+	"testing"
+)
+
+// getAllNodeStates return data, as base64 encoded by the proxy
+var fixtureNodeStates = []string{
+	"c3Rha2Vk",
+	"H0D8ktokFpR1CXnubPWC8tXX0o4YM13gWrxU0FYOD1MChgxlK/CNVgJSql50IQVG82n7u86MEs/HlXsmUv6adcqXgRLKG73K+sIxs5oj3E2nhu/4FHxOcrmAd4Wv7ki7",
+	"Umd2iCLuYk1I/OFexcp5y9YCy39MIVelFlVpkfIu+Me173sY0f9BxZNw77CFhlHUSpNsEbexRMSP4E3zxqPo2j4j6BYAOVlKM4lPZWThsTSLvXoAiNQsSstz7q7VnACd",
+	"bm90U3Rha2Vk",
+	"rMKNsr63tCuqHLAkPUAcy04/zkTXsCh5pSeZqt/1QVItiCJZiy+mZPnVFWwAySSAXXXDhovVbCrLgdN+mONa3C59LAOpUHriZez1tTVohaUzk6ICnSQTlJlyZaGiWu/G",
+	"dW5TdGFrZWQ=",
+	"SPsQsV89RKCdyC0CsGWB4MDGlHjJ/Sz4+Qk2WQGaFoe67NuzjJ5ysSFp3EFIaQ+HRn+RVPWTHF32ZcZJbL/V9RisPnND8BaJDFEOk/k1JhFp2eP1ZUNkKYMPrwk09Pjk",
+}
+
+func TestParseNodeStates(t *testing.T) {
+	returnData := make([][]byte, 0, len(fixtureNodeStates))
+	for _, item := range fixtureNodeStates {
+		bytes, err := base64.StdEncoding.DecodeString(item)
+		if err != nil {
+			t.Fatalf("invalid fixture: %v", err)
+		}
+		returnData = append(returnData, bytes)
+	}
+
+	expected := map[string]string{
+		"1f40fc92da241694750979ee6cf582f2d5d7d28e18335de05abc54d0560e0f5302860c652bf08d560252aa5e74210546f369fbbbce8c12cfc7957b2652fe9a75ca978112ca1bbdcafac231b39a23dc4da786eff8147c4e72b9807785afee48bb": NodeStatusStaked,
+		"5267768822ee624d48fce15ec5ca79cbd602cb7f4c2157a516556991f22ef8c7b5ef7b18d1ff41c59370efb0858651d44a936c11b7b144c48fe04df3c6a3e8da3e23e8160039594a33894f6564e1b1348bbd7a0088d42c4acb73eeaed59c009d": NodeStatusStaked,
+		"acc28db2beb7b42baa1cb0243d401ccb4e3fce44d7b02879a52799aadff541522d8822598b2fa664f9d5156c00c924805d75c3868bd56c2acb81d37e98e35adc2e7d2c03a9507ae265ecf5b5356885a53393a2029d241394997265a1a25aefc6": NodeStatusNotStaked,
+		"48fb10b15f3d44a09dc82d02b06581e0c0c69478c9fd2cf8f9093659019a1687baecdbb38c9e72b12169dc4148690f87467f9154f5931c5df665c6496cbfd5f518ac3e7343f016890c510e93f935261169d9e3f565436429830faf0934f4f8e4": NodeStatusUnStaked,
+	}
+
+	nodes := parseNodeStates(returnData)
+	if len(nodes) != len(expected) {
+		t.Fatalf("expected %v nodes, got %v", len(expected), len(nodes))
+	}
+	for blsKey, state := range expected {
+		node := nodes[blsKey]
+		if node == nil {
+			t.Errorf("node %s not found", blsKey)
+			continue
+		}
+		if node.BlsKey != blsKey || node.DelegationState != state {
+			t.Errorf("node %s: expected state %s, got %+v", blsKey, state, node)
+		}
+	}
+}
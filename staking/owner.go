@@ -0,0 +1,217 @@
+package staking
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/stakingagency/sa-mx-sdk-go/data"
+	"github.com/stakingagency/sa-mx-sdk-go/network"
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+const (
+	unJailCostPerNode = 2.5
+	blsKeyLength      = 96
+	blsSignatureLen   = 48
+
+	adminGasLimit   = uint64(6000000)
+	perNodeGasLimit = uint64(6000000)
+)
+
+type NodeKey struct {
+	BlsKey    string
+	Signature string
+}
+
+func (st *Staking) GetServiceFeeLimits() (minFee float64, maxFee float64, err error) {
+	cfg, err := st.getDelegationManagerConfig()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return cfg.minServiceFee, cfg.maxServiceFee, nil
+}
+
+func (st *Staking) ChangeServiceFee(pk []byte, providerAddress string, newFee float64) error {
+	cfg, err := st.getOwnedProviderConfig(pk, providerAddress)
+	if err != nil {
+		return err
+	}
+
+	minFee, maxFee, err := st.GetServiceFeeLimits()
+	if err != nil {
+		return err
+	}
+
+	if newFee < minFee || newFee > maxFee {
+		return utils.ErrInvalidServiceFee
+	}
+
+	if newFee == cfg.ServiceFee {
+		return utils.ErrNoChange
+	}
+
+	fee := utils.Renominate(newFee, 2)
+
+	return st.sendOwnerTx(pk, providerAddress, 0, adminGasLimit, "changeServiceFee", utils.BigIntArg(fee))
+}
+
+func (st *Staking) ModifyTotalDelegationCap(pk []byte, providerAddress string, newCap float64) error {
+	cfg, err := st.getOwnedProviderConfig(pk, providerAddress)
+	if err != nil {
+		return err
+	}
+
+	if newCap != 0 && newCap < cfg.ActiveStake {
+		return utils.ErrInvalidDelegationCap
+	}
+
+	iCap := utils.Renominate(newCap, 18)
+
+	return st.sendOwnerTx(pk, providerAddress, 0, adminGasLimit, "modifyTotalDelegationCap", utils.BigIntArg(iCap))
+}
+
+func (st *Staking) SetMetaData(pk []byte, providerAddress string, name string, website string, identity string) error {
+	_, err := st.getOwnedProviderConfig(pk, providerAddress)
+	if err != nil {
+		return err
+	}
+
+	return st.sendOwnerTx(pk, providerAddress, 0, adminGasLimit, "setMetaData",
+		utils.StringArg(name), utils.StringArg(website), utils.StringArg(identity))
+}
+
+func (st *Staking) SetAutomaticActivation(pk []byte, providerAddress string, enabled bool) error {
+	cfg, err := st.getOwnedProviderConfig(pk, providerAddress)
+	if err != nil {
+		return err
+	}
+
+	if cfg.AutomaticActivation == enabled {
+		return utils.ErrNoChange
+	}
+
+	return st.sendOwnerTx(pk, providerAddress, 0, adminGasLimit, "setAutomaticActivation", boolStringArg(enabled))
+}
+
+func (st *Staking) SetCheckCapOnRedelegateRewards(pk []byte, providerAddress string, enabled bool) error {
+	cfg, err := st.getOwnedProviderConfig(pk, providerAddress)
+	if err != nil {
+		return err
+	}
+
+	if cfg.CheckCapOnRedelegate == enabled {
+		return utils.ErrNoChange
+	}
+
+	return st.sendOwnerTx(pk, providerAddress, 0, adminGasLimit, "setCheckCapOnReDelegateRewards", boolStringArg(enabled))
+}
+
+func (st *Staking) AddNodes(pk []byte, providerAddress string, nodes []*NodeKey) error {
+	_, err := st.getOwnedProviderConfig(pk, providerAddress)
+	if err != nil {
+		return err
+	}
+
+	args := make([]string, 0)
+	for _, node := range nodes {
+		if !isValidHex(node.BlsKey, blsKeyLength) || !isValidHex(node.Signature, blsSignatureLen) {
+			return utils.ErrInvalidBlsKey
+		}
+
+		args = append(args, node.BlsKey, node.Signature)
+	}
+
+	return st.sendNodesTx(pk, providerAddress, 0, "addNodes", args)
+}
+
+func (st *Staking) RemoveNodes(pk []byte, providerAddress string, blsKeys []string) error {
+	return st.nodesOperation(pk, providerAddress, 0, "removeNodes", blsKeys)
+}
+
+func (st *Staking) StakeNodes(pk []byte, providerAddress string, blsKeys []string) error {
+	return st.nodesOperation(pk, providerAddress, 0, "stakeNodes", blsKeys)
+}
+
+func (st *Staking) UnstakeNodes(pk []byte, providerAddress string, blsKeys []string) error {
+	return st.nodesOperation(pk, providerAddress, 0, "unStakeNodes", blsKeys)
+}
+
+func (st *Staking) UnbondNodes(pk []byte, providerAddress string, blsKeys []string) error {
+	return st.nodesOperation(pk, providerAddress, 0, "unBondNodes", blsKeys)
+}
+
+func (st *Staking) UnjailNodes(pk []byte, providerAddress string, blsKeys []string) error {
+	return st.nodesOperation(pk, providerAddress, unJailCostPerNode*float64(len(blsKeys)), "unJailNodes", blsKeys)
+}
+
+func (st *Staking) nodesOperation(pk []byte, providerAddress string, value float64, function string, blsKeys []string) error {
+	_, err := st.getOwnedProviderConfig(pk, providerAddress)
+	if err != nil {
+		return err
+	}
+
+	for _, blsKey := range blsKeys {
+		if !isValidHex(blsKey, blsKeyLength) {
+			return utils.ErrInvalidBlsKey
+		}
+	}
+
+	return st.sendNodesTx(pk, providerAddress, value, function, blsKeys)
+}
+
+func (st *Staking) sendNodesTx(pk []byte, providerAddress string, value float64, function string, blsKeys []string) error {
+	if len(blsKeys) == 0 {
+		return utils.ErrInvalidBlsKey
+	}
+
+	gasLimit := adminGasLimit + perNodeGasLimit*uint64(len(blsKeys))
+
+	return st.sendOwnerTx(pk, providerAddress, value, gasLimit, function, blsKeys...)
+}
+
+func (st *Staking) getOwnedProviderConfig(pk []byte, providerAddress string) (*data.StakingProvider, error) {
+	sender, err := network.GetAddressFromPrivateKey(pk)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := st.GetProviderConfig(providerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Owner != sender {
+		return nil, utils.ErrNotOwner
+	}
+
+	return cfg, nil
+}
+
+func (st *Staking) sendOwnerTx(pk []byte, providerAddress string, value float64, gasLimit uint64, function string, args ...string) error {
+	dataField := function
+	if len(args) > 0 {
+		dataField += "@" + strings.Join(args, "@")
+	}
+
+	hash, err := st.netMan.SendTransaction(pk, providerAddress, value, gasLimit, dataField, utils.AutoNonce)
+	if err != nil {
+		return err
+	}
+
+	return st.netMan.GetTxResult(hash)
+}
+
+func boolStringArg(value bool) string {
+	if value {
+		return utils.StringArg("true")
+	}
+
+	return utils.StringArg("false")
+}
+
+func isValidHex(s string, length int) bool {
+	bytes, err := hex.DecodeString(s)
+
+	return err == nil && len(bytes) == length
+}
@@ -23,6 +23,12 @@ type (
 	ProviderSpaceAvailableCallbackFunc func(providerAddress string, spaceAvailable float64)
 	NewProviderCallbackFunc            func(providerAddress string)
 	ProviderClosedCallbackFunc         func(providerAddress string)
+	NodeJailedCallbackFunc             func(providerAddress string, blsKey string)
+	NodeLeftQueueCallbackFunc          func(providerAddress string, blsKey string, newStatus string)
+	NodeStatusChangedCallbackFunc      func(providerAddress string, blsKey string, oldStatus string, newStatus string)
+	LargeDelegationCallbackFunc        func(providerAddress string, delegator string, amount float64)
+	LargeUndelegationCallbackFunc      func(providerAddress string, delegator string, amount float64)
+	UnbondReadyCallbackFunc            func(providerAddress string, delegator string, amount float64)
 )
 
 type Staking struct {
@@ -32,6 +38,15 @@ type Staking struct {
 	cachedProviders    map[string]*data.StakingProvider
 	cachedProvidersMut sync.Mutex
 
+	cachedNodes    map[string]map[string]*data.ProviderNode
+	cachedNodesMut sync.Mutex
+
+	cachedDelegators         map[string]map[string]float64
+	largeDelegationThreshold float64
+
+	watchedUnbondings    map[string]map[string]float64
+	watchedUnbondingsMut sync.Mutex
+
 	providerOwnerChangedCallback   ProviderOwnerChangedCallbackFunc
 	providerNameChangedCallback    ProviderNameChangedCallbackFunc
 	providerFeeChangedCallback     ProviderFeeChangedCallbackFunc
@@ -39,6 +54,12 @@ type Staking struct {
 	providerSpaceAvailableCallback ProviderSpaceAvailableCallbackFunc
 	newProviderCallback            NewProviderCallbackFunc
 	providerClosedCallback         ProviderClosedCallbackFunc
+	nodeJailedCallback             NodeJailedCallbackFunc
+	nodeLeftQueueCallback          NodeLeftQueueCallbackFunc
+	nodeStatusChangedCallback      NodeStatusChangedCallbackFunc
+	largeDelegationCallback        LargeDelegationCallbackFunc
+	largeUndelegationCallback      LargeUndelegationCallbackFunc
+	unbondReadyCallback            UnbondReadyCallbackFunc
 }
 
 var log = logger.GetOrCreate("staking")
@@ -49,6 +70,12 @@ func NewStaking(netMan *network.NetworkManager, refreshInterval time.Duration) (
 		refreshInterval: refreshInterval,
 
 		cachedProviders: make(map[string]*data.StakingProvider),
+		cachedNodes:     make(map[string]map[string]*data.ProviderNode),
+
+		cachedDelegators:         nil,
+		largeDelegationThreshold: defaultLargeDelegationThreshold,
+
+		watchedUnbondings: make(map[string]map[string]float64),
 
 		providerOwnerChangedCallback:   nil,
 		providerNameChangedCallback:    nil,
@@ -57,6 +84,12 @@ func NewStaking(netMan *network.NetworkManager, refreshInterval time.Duration) (
 		providerSpaceAvailableCallback: nil,
 		newProviderCallback:            nil,
 		providerClosedCallback:         nil,
+		nodeJailedCallback:             nil,
+		nodeLeftQueueCallback:          nil,
+		nodeStatusChangedCallback:      nil,
+		largeDelegationCallback:        nil,
+		largeUndelegationCallback:      nil,
+		unbondReadyCallback:            nil,
 	}
 	st.startTasks()
 
@@ -91,6 +124,34 @@ func (st *Staking) SetProviderSpaceAvailableCallback(f ProviderSpaceAvailableCal
 	st.providerSpaceAvailableCallback = f
 }
 
+func (st *Staking) SetNodeJailedCallback(f NodeJailedCallbackFunc) {
+	st.nodeJailedCallback = f
+}
+
+func (st *Staking) SetNodeLeftQueueCallback(f NodeLeftQueueCallbackFunc) {
+	st.nodeLeftQueueCallback = f
+}
+
+func (st *Staking) SetNodeStatusChangedCallback(f NodeStatusChangedCallbackFunc) {
+	st.nodeStatusChangedCallback = f
+}
+
+func (st *Staking) SetLargeDelegationCallback(f LargeDelegationCallbackFunc) {
+	st.largeDelegationCallback = f
+}
+
+func (st *Staking) SetLargeUndelegationCallback(f LargeUndelegationCallbackFunc) {
+	st.largeUndelegationCallback = f
+}
+
+func (st *Staking) SetUnbondReadyCallback(f UnbondReadyCallbackFunc) {
+	st.unbondReadyCallback = f
+}
+
+func (st *Staking) SetLargeDelegationThreshold(threshold float64) {
+	st.largeDelegationThreshold = threshold
+}
+
 func (st *Staking) GetAllProvidersAddresses() ([]string, error) {
 	converter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
 	query := &sdkData.VmValueRequest{
@@ -224,6 +285,11 @@ func (st *Staking) GetProviderConfig(providerAddress string) (*data.StakingProvi
 		ServiceFee:       utils.Denominate(res[1], 0) / 100,
 		MaxDelegationCap: utils.Denominate(res[2], 18),
 		HasDelegationCap: string(res[5].Bytes()) == "true",
+
+		AutomaticActivation:  string(res[4].Bytes()) == "true",
+		ChangeableServiceFee: string(res[6].Bytes()) == "true",
+		CheckCapOnRedelegate: string(res[7].Bytes()) == "true",
+		UnbondPeriod:         res[9].Uint64(),
 	}
 	cfg.Name, cfg.Website, cfg.Identity, err = st.GetMetaData(providerAddress)
 	if err != nil {
@@ -3,6 +3,7 @@ package staking
 import (
 	"time"
 
+	"github.com/stakingagency/sa-mx-sdk-go/data"
 	"github.com/stakingagency/sa-mx-sdk-go/utils"
 )
 
@@ -18,6 +19,9 @@ func (st *Staking) startTasks() {
 			startTime := time.Now().UnixNano()
 
 			st.refreshProviders()
+			st.refreshNodes()
+			st.refreshDelegators()
+			st.refreshUnbondings()
 
 			endTime := time.Now().UnixNano()
 			waitTime := st.refreshInterval - time.Duration(endTime-startTime)
@@ -80,3 +84,136 @@ func (st *Staking) refreshProviders() {
 	st.cachedProviders = newProviders
 	st.cachedProvidersMut.Unlock()
 }
+
+func (st *Staking) refreshNodes() {
+	if st.nodeJailedCallback == nil && st.nodeLeftQueueCallback == nil && st.nodeStatusChangedCallback == nil {
+		return
+	}
+
+	stats, err := st.GetValidatorStatistics()
+	if err != nil {
+		log.Error("get validator statistics", "error", err, "function", "refreshNodes")
+		return
+	}
+
+	st.cachedProvidersMut.Lock()
+	addresses := make([]string, 0, len(st.cachedProviders))
+	for address := range st.cachedProviders {
+		addresses = append(addresses, address)
+	}
+	st.cachedProvidersMut.Unlock()
+
+	for _, address := range addresses {
+		nodes, err := st.getProviderNodes(address, stats)
+		if err != nil {
+			log.Error("get provider nodes", "error", err, "provider", address, "function", "refreshNodes")
+			continue
+		}
+
+		newNodes := make(map[string]*data.ProviderNode)
+		for _, node := range nodes {
+			newNodes[node.BlsKey] = node
+		}
+
+		st.cachedNodesMut.Lock()
+		oldNodes := st.cachedNodes[address]
+		st.cachedNodes[address] = newNodes
+		st.cachedNodesMut.Unlock()
+		if oldNodes == nil {
+			continue
+		}
+
+		for blsKey, newNode := range newNodes {
+			oldNode := oldNodes[blsKey]
+			if oldNode == nil || oldNode.Status == newNode.Status {
+				continue
+			}
+
+			if newNode.Status == NodeStatusJailed && st.nodeJailedCallback != nil {
+				st.nodeJailedCallback(address, blsKey)
+			}
+			if oldNode.Status == NodeStatusQueued && st.nodeLeftQueueCallback != nil {
+				st.nodeLeftQueueCallback(address, blsKey, newNode.Status)
+			}
+			if st.nodeStatusChangedCallback != nil {
+				st.nodeStatusChangedCallback(address, blsKey, oldNode.Status, newNode.Status)
+			}
+		}
+	}
+}
+
+func (st *Staking) refreshDelegators() {
+	if st.largeDelegationCallback == nil && st.largeUndelegationCallback == nil {
+		return
+	}
+
+	newDelegators, err := st.getAllDelegators()
+	if err != nil {
+		log.Error("get all delegators", "error", err, "function", "refreshDelegators")
+		return
+	}
+
+	oldDelegators := st.cachedDelegators
+	st.cachedDelegators = newDelegators
+	if oldDelegators == nil {
+		return
+	}
+
+	for provider, delegators := range newDelegators {
+		for address, newStake := range delegators {
+			diff := newStake - oldDelegators[provider][address]
+			if diff >= st.largeDelegationThreshold && st.largeDelegationCallback != nil {
+				st.largeDelegationCallback(provider, address, diff)
+			}
+			if -diff >= st.largeDelegationThreshold && st.largeUndelegationCallback != nil {
+				st.largeUndelegationCallback(provider, address, -diff)
+			}
+		}
+	}
+	for provider, delegators := range oldDelegators {
+		for address, oldStake := range delegators {
+			_, exists := newDelegators[provider][address]
+			if !exists && oldStake >= st.largeDelegationThreshold && st.largeUndelegationCallback != nil {
+				st.largeUndelegationCallback(provider, address, oldStake)
+			}
+		}
+	}
+}
+
+func (st *Staking) refreshUnbondings() {
+	if st.unbondReadyCallback == nil {
+		return
+	}
+
+	st.watchedUnbondingsMut.Lock()
+	watched := make(map[string]map[string]float64)
+	for provider, delegators := range st.watchedUnbondings {
+		watched[provider] = make(map[string]float64)
+		for address, amount := range delegators {
+			watched[provider][address] = amount
+		}
+	}
+	st.watchedUnbondingsMut.Unlock()
+
+	for provider, delegators := range watched {
+		for address, oldAmount := range delegators {
+			newAmount, err := st.GetWithdrawableAmount(address, provider)
+			if err != nil {
+				log.Error("get withdrawable amount", "error", err, "address", address, "provider", provider, "function", "refreshUnbondings")
+				continue
+			}
+
+			if oldAmount >= 0 && newAmount > oldAmount {
+				st.unbondReadyCallback(provider, address, newAmount-oldAmount)
+			}
+
+			st.watchedUnbondingsMut.Lock()
+			if st.watchedUnbondings[provider] != nil {
+				if _, exists := st.watchedUnbondings[provider][address]; exists {
+					st.watchedUnbondings[provider][address] = newAmount
+				}
+			}
+			st.watchedUnbondingsMut.Unlock()
+		}
+	}
+}
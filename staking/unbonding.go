@@ -0,0 +1,156 @@
+package staking
+
+import (
+	"sort"
+	"time"
+
+	"github.com/stakingagency/sa-mx-sdk-go/data"
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+func (st *Staking) GetUndelegations(address string, providerAddress string) ([]*data.Undelegation, error) {
+	sAddress, err := utils.AddressArg(address)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := st.netMan.QueryScMultiIntResult(providerAddress, "getUserUnDelegatedList", []string{sAddress})
+	if err != nil {
+		log.Error("query vm", "error", err, "function", "GetUndelegations")
+		return nil, err
+	}
+
+	if len(res)%2 != 0 {
+		return nil, utils.ErrInvalidResponse
+	}
+
+	netStatus, err := st.netMan.GetNetworkStatus()
+	if err != nil {
+		log.Error("get network status", "error", err, "function", "GetUndelegations")
+		return nil, err
+	}
+
+	netCfg := st.netMan.GetNetworkConfig()
+	roundDuration := time.Duration(netCfg.RoundDuration) * time.Millisecond
+	epochDuration := roundDuration * time.Duration(netCfg.RoundsPerEpoch)
+	epochElapsed := roundDuration * time.Duration(netStatus.RoundsPassedInCurrentEpoch)
+
+	undelegations := make([]*data.Undelegation, 0, len(res)/2)
+	for i := 0; i < len(res); i += 2 {
+		undelegation := &data.Undelegation{
+			Amount:          utils.Denominate(res[i], 18),
+			RemainingEpochs: res[i+1].Uint64(),
+		}
+		undelegation.UnbondEpoch = netStatus.EpochNumber + undelegation.RemainingEpochs
+		undelegation.Withdrawable = undelegation.RemainingEpochs == 0
+		if !undelegation.Withdrawable {
+			undelegation.Countdown = epochDuration*time.Duration(undelegation.RemainingEpochs) - epochElapsed
+			if undelegation.Countdown < 0 {
+				undelegation.Countdown = 0
+			}
+		}
+		undelegation.UnbondTime = time.Now().Add(undelegation.Countdown)
+		undelegations = append(undelegations, undelegation)
+	}
+	sort.Slice(undelegations, func(i, j int) bool {
+		return undelegations[i].RemainingEpochs < undelegations[j].RemainingEpochs
+	})
+
+	return undelegations, nil
+}
+
+func (st *Staking) GetWithdrawableAmount(address string, providerAddress string) (float64, error) {
+	undelegations, err := st.GetUndelegations(address, providerAddress)
+	if err != nil {
+		return 0, err
+	}
+
+	amount := float64(0)
+	for _, undelegation := range undelegations {
+		if undelegation.Withdrawable {
+			amount += undelegation.Amount
+		}
+	}
+
+	return amount, nil
+}
+
+func (st *Staking) GetRewardsHistory(address string, providerAddress string) ([]*data.RewardsHistoryEntry, error) {
+	query := map[string]interface{}{
+		"bool": map[string]interface{}{
+			"must": []interface{}{
+				map[string]interface{}{"match": map[string]string{"sender": address}},
+				map[string]interface{}{"match": map[string]string{"receiver": providerAddress}},
+				map[string]interface{}{"match": map[string]string{"status": "success"}},
+				map[string]interface{}{"terms": map[string][]string{"function": {"claimRewards", "reDelegateRewards"}}},
+			},
+		},
+	}
+	sortBy := []interface{}{
+		map[string]string{"timestamp": "asc"},
+	}
+	ops, err := st.netMan.SearchIndexer("operations", query, sortBy)
+	if err != nil {
+		log.Error("search indexer", "error", err, "function", "GetRewardsHistory")
+		return nil, err
+	}
+
+	res := make([]*data.RewardsHistoryEntry, 0, len(ops))
+	for _, op := range ops {
+		if op.Source.Function != "claimRewards" && op.Source.Function != "reDelegateRewards" {
+			continue
+		}
+
+		amount, err := st.getOperationAmount(op.Hash, op.Source.Function)
+		if err != nil {
+			log.Warn("get operation amount", "error", err, "hash", op.Hash, "function", "GetRewardsHistory")
+			continue
+		}
+
+		res = append(res, &data.RewardsHistoryEntry{
+			TxHash:    op.Hash,
+			Operation: op.Source.Function,
+			Amount:    amount,
+			Timestamp: op.Source.Timestamp,
+		})
+	}
+
+	return res, nil
+}
+
+func (st *Staking) GetTotalRewards(address string, providerAddress string) (float64, error) {
+	history, err := st.GetRewardsHistory(address, providerAddress)
+	if err != nil {
+		return 0, err
+	}
+
+	total := float64(0)
+	for _, entry := range history {
+		total += entry.Amount
+	}
+
+	_, iReward, _, _, err := st.GetUserStakeInfo(address, providerAddress)
+	if err != nil {
+		return 0, err
+	}
+
+	return total + utils.Denominate(iReward, 18), nil
+}
+
+func (st *Staking) WatchUnbonding(address string, providerAddress string) {
+	st.watchedUnbondingsMut.Lock()
+	if st.watchedUnbondings[providerAddress] == nil {
+		st.watchedUnbondings[providerAddress] = make(map[string]float64)
+	}
+	st.watchedUnbondings[providerAddress][address] = -1
+	st.watchedUnbondingsMut.Unlock()
+}
+
+func (st *Staking) UnwatchUnbonding(address string, providerAddress string) {
+	st.watchedUnbondingsMut.Lock()
+	delete(st.watchedUnbondings[providerAddress], address)
+	if len(st.watchedUnbondings[providerAddress]) == 0 {
+		delete(st.watchedUnbondings, providerAddress)
+	}
+	st.watchedUnbondingsMut.Unlock()
+}
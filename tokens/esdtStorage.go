@@ -0,0 +1,200 @@
+package tokens
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/stakingagency/sa-mx-sdk-go/data"
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+// field numbers of the ESDTDataV2 and ESDTRoles messages (mx-chain-go vm/systemSmartContracts/esdt.proto)
+const (
+	fieldOwnerAddress             = 1
+	fieldTokenName                = 2
+	fieldTickerName               = 3
+	fieldTokenType                = 4
+	fieldMintable                 = 5
+	fieldBurnable                 = 6
+	fieldCanPause                 = 7
+	fieldCanFreeze                = 8
+	fieldCanWipe                  = 9
+	fieldUpgradable               = 10
+	fieldCanChangeOwner           = 11
+	fieldPaused                   = 12
+	fieldMintedValue              = 13
+	fieldBurntValue               = 14
+	fieldNumDecimals              = 15
+	fieldCanAddSpecialRoles       = 16
+	fieldNFTCreateStopped         = 17
+	fieldCanTransferNFTCreateRole = 18
+	fieldSpecialRoles             = 19
+	fieldNumWiped                 = 20
+	fieldCanCreateMultiShard      = 21
+
+	fieldRolesAddress = 1
+	fieldRolesRoles   = 2
+)
+
+func DecodeEsdtStorage(bytes []byte) (*data.EsdtStorage, error) {
+	fields, err := utils.ParseProtoFields(bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	conv, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	res := &data.EsdtStorage{
+		MintedValue:  big.NewInt(0),
+		BurntValue:   big.NewInt(0),
+		SpecialRoles: make(map[string][]string),
+	}
+	for _, field := range fields {
+		switch field.Number {
+		case fieldOwnerAddress:
+			err = expectWireType(field, utils.ProtoWireBytes)
+			if err == nil {
+				res.Owner, err = conv.Encode(field.Bytes)
+			}
+		case fieldTokenName:
+			err = expectWireType(field, utils.ProtoWireBytes)
+			res.TokenName = string(field.Bytes)
+		case fieldTickerName:
+			err = expectWireType(field, utils.ProtoWireBytes)
+			res.TickerName = string(field.Bytes)
+		case fieldTokenType:
+			err = expectWireType(field, utils.ProtoWireBytes)
+			res.TokenType = string(field.Bytes)
+		case fieldMintable:
+			res.Mintable, err = decodeBool(field)
+		case fieldBurnable:
+			res.Burnable, err = decodeBool(field)
+		case fieldUpgradable:
+			res.Upgradable, err = decodeBool(field)
+		case fieldPaused:
+			res.Paused, err = decodeBool(field)
+		case fieldMintedValue:
+			res.MintedValue, err = decodeBigInt(field)
+		case fieldBurntValue:
+			res.BurntValue, err = decodeBigInt(field)
+		case fieldNumDecimals:
+			res.NumDecimals, err = decodeUint32(field)
+		case fieldCanPause:
+			res.CanPause, err = decodeBool(field)
+		case fieldCanFreeze:
+			res.CanFreeze, err = decodeBool(field)
+		case fieldCanWipe:
+			res.CanWipe, err = decodeBool(field)
+		case fieldCanChangeOwner:
+			res.CanChangeOwner, err = decodeBool(field)
+		case fieldCanAddSpecialRoles:
+			res.CanAddSpecialRoles, err = decodeBool(field)
+		case fieldCanTransferNFTCreateRole:
+			res.CanTransferNFTCreateRole, err = decodeBool(field)
+		case fieldNFTCreateStopped:
+			res.NFTCreateStopped, err = decodeBool(field)
+		case fieldSpecialRoles:
+			var address string
+			var roles []string
+			address, roles, err = decodeRoles(field)
+			if err == nil {
+				res.SpecialRoles[address] = append(res.SpecialRoles[address], roles...)
+			}
+		case fieldNumWiped:
+			res.NumWiped, err = decodeUint32(field)
+		case fieldCanCreateMultiShard:
+			res.CanCreateMultiShard, err = decodeBool(field)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("decode esdt storage field %v: %w", field.Number, err)
+		}
+	}
+
+	if res.TokenType == "" || res.TickerName == "" {
+		return nil, fmt.Errorf("%w: missing token type or ticker", utils.ErrInvalidProtobuf)
+	}
+
+	return res, nil
+}
+
+func expectWireType(field *utils.ProtoField, wireType byte) error {
+	if field.WireType != wireType {
+		return fmt.Errorf("%w: unexpected wire type %v", utils.ErrInvalidProtobuf, field.WireType)
+	}
+
+	return nil
+}
+
+func decodeBool(field *utils.ProtoField) (bool, error) {
+	err := expectWireType(field, utils.ProtoWireVarint)
+	if err != nil {
+		return false, err
+	}
+
+	return field.Varint != 0, nil
+}
+
+func decodeUint32(field *utils.ProtoField) (uint32, error) {
+	err := expectWireType(field, utils.ProtoWireVarint)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint32(field.Varint), nil
+}
+
+func decodeBigInt(field *utils.ProtoField) (*big.Int, error) {
+	err := expectWireType(field, utils.ProtoWireBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(field.Bytes) <= 1 {
+		return big.NewInt(0), nil
+	}
+
+	value := big.NewInt(0).SetBytes(field.Bytes[1:])
+	switch field.Bytes[0] {
+	case 0:
+	case 1:
+		value.Neg(value)
+	default:
+		return nil, fmt.Errorf("%w: invalid big int sign byte %v", utils.ErrInvalidProtobuf, field.Bytes[0])
+	}
+
+	return value, nil
+}
+
+func decodeRoles(field *utils.ProtoField) (string, []string, error) {
+	err := expectWireType(field, utils.ProtoWireBytes)
+	if err != nil {
+		return "", nil, err
+	}
+
+	fields, err := utils.ParseProtoFields(field.Bytes)
+	if err != nil {
+		return "", nil, err
+	}
+
+	conv, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	address := ""
+	roles := make([]string, 0)
+	for _, roleField := range fields {
+		err = expectWireType(roleField, utils.ProtoWireBytes)
+		if err != nil {
+			return "", nil, err
+		}
+
+		switch roleField.Number {
+		case fieldRolesAddress:
+			address, err = conv.Encode(roleField.Bytes)
+			if err != nil {
+				return "", nil, err
+			}
+		case fieldRolesRoles:
+			roles = append(roles, string(roleField.Bytes))
+		}
+	}
+
+	return address, roles, nil
+}
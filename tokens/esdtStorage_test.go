@@ -0,0 +1,160 @@
+package tokens
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+// ESDTDataV2 blobs as stored by the ESDT system SC (marshalled with mx-chain-go's systemSmartContracts.ESDTDataV2)
+const (
+	fixtureFungible        = "0a2000000000000000000500ce7eab736978ce9492ebbf8206f252eacb333cfa5483120b5772617070656445474c441a055745474c44220c46756e6769626c654553445428013001380140014801500158016a0200007202000078128001019a01480a2000000000000000000500f12dd10c4d2be8264fe339da14b9fad7bdf364ae7ceb121145534454526f6c654c6f63616c4d696e74121145534454526f6c654c6f63616c4275726e"
+	fixtureFungibleNoRoles = "0a2000000000000000000500ce7eab736978ce9492ebbf8206f252eacb333cfa54831205546f6b656e1a03544b4e220c46756e6769626c6545534454380160016a0a003635c9adc5dea00000720200057806a00102"
+	fixtureNFT             = "0a2000000000000000000500ce7eab736978ce9492ebbf8206f252eacb333cfa5483120a436f6c6c656374696f6e1a03434f4c220f4e6f6e46756e6769626c6545534454400148016a01007201008801019001019a01460a2000000000000000000500f12dd10c4d2be8264fe339da14b9fad7bdf364ae7ceb121145534454526f6c654e4654437265617465120f45534454526f6c654e46544275726e9a01340a2000000000000000000500ce7eab736978ce9492ebbf8206f252eacb333cfa54831210455344545472616e73666572526f6c65a80101"
+	fixtureSFT             = "0a2000000000000000000500ce7eab736978ce9492ebbf8206f252eacb333cfa548312054974656d731a044954454d221053656d6946756e6769626c654553445458016a01007201008001019a014d0a2000000000000000000500f12dd10c4d2be8264fe339da14b9fad7bdf364ae7ceb121145534454526f6c654e4654437265617465121645534454526f6c654e46544164645175616e74697479"
+	fixtureMetaESDT        = "0a2000000000000000000500ce7eab736978ce9492ebbf8206f252eacb333cfa5483120b4c6f636b656441737365741a054c4b4d455822084d6574614553445450016a01007201007812"
+
+	fixtureOwner  = "erd1qqqqqqqqqqqqqpgqeel2kumf0r8ffyhth7pqdujjat9nx0862jpsg2pqaq"
+	fixtureHolder = "erd1qqqqqqqqqqqqqpgq7ykazrzd905zvnlr88dpfw06677lxe9w0n4suz00uh"
+)
+
+func decodeFixture(t *testing.T, fixture string) []byte {
+	bytes, err := hex.DecodeString(fixture)
+	if err != nil {
+		t.Fatalf("invalid fixture: %v", err)
+	}
+
+	return bytes
+}
+
+func TestDecodeEsdtStorageFungible(t *testing.T) {
+	res, err := DecodeEsdtStorage(decodeFixture(t, fixtureFungible))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if res.Owner != fixtureOwner || res.TokenName != "WrappedEGLD" || res.TickerName != "WEGLD" || res.TokenType != "FungibleESDT" {
+		t.Errorf("unexpected token details: %+v", res)
+	}
+	if !res.Mintable || !res.Burnable || !res.CanPause || !res.CanFreeze || !res.CanWipe || !res.Upgradable || !res.CanChangeOwner || !res.CanAddSpecialRoles {
+		t.Errorf("unexpected flags: %+v", res)
+	}
+	if res.Paused || res.NFTCreateStopped || res.CanTransferNFTCreateRole || res.CanCreateMultiShard {
+		t.Errorf("unexpected flags: %+v", res)
+	}
+	if res.NumDecimals != 18 || res.MintedValue.Sign() != 0 || res.BurntValue.Sign() != 0 {
+		t.Errorf("unexpected supply: decimals %v, minted %v, burnt %v", res.NumDecimals, res.MintedValue, res.BurntValue)
+	}
+
+	expectedRoles := map[string][]string{
+		fixtureHolder: {"ESDTRoleLocalMint", "ESDTRoleLocalBurn"},
+	}
+	if !reflect.DeepEqual(res.SpecialRoles, expectedRoles) {
+		t.Errorf("unexpected roles: %v", res.SpecialRoles)
+	}
+}
+
+func TestDecodeEsdtStorageFungibleWithoutRoles(t *testing.T) {
+	res, err := DecodeEsdtStorage(decodeFixture(t, fixtureFungibleNoRoles))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	minted, _ := big.NewInt(0).SetString("1000000000000000000000", 10)
+	if res.TickerName != "TKN" || res.TokenType != "FungibleESDT" || res.NumDecimals != 6 || res.NumWiped != 2 {
+		t.Errorf("unexpected token details: %+v", res)
+	}
+	if !res.CanPause || !res.Paused || res.Mintable || res.CanFreeze {
+		t.Errorf("unexpected flags: %+v", res)
+	}
+	if res.MintedValue.Cmp(minted) != 0 || res.BurntValue.Cmp(big.NewInt(5)) != 0 {
+		t.Errorf("unexpected supply: minted %v, burnt %v", res.MintedValue, res.BurntValue)
+	}
+	if len(res.SpecialRoles) != 0 {
+		t.Errorf("unexpected roles: %v", res.SpecialRoles)
+	}
+}
+
+func TestDecodeEsdtStorageNFT(t *testing.T) {
+	res, err := DecodeEsdtStorage(decodeFixture(t, fixtureNFT))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if res.TickerName != "COL" || res.TokenType != "NonFungibleESDT" || res.NumDecimals != 0 {
+		t.Errorf("unexpected token details: %+v", res)
+	}
+	if !res.CanFreeze || !res.CanWipe || !res.CanTransferNFTCreateRole || !res.NFTCreateStopped || !res.CanCreateMultiShard || res.CanPause {
+		t.Errorf("unexpected flags: %+v", res)
+	}
+
+	expectedRoles := map[string][]string{
+		fixtureHolder: {"ESDTRoleNFTCreate", "ESDTRoleNFTBurn"},
+		fixtureOwner:  {"ESDTTransferRole"},
+	}
+	if !reflect.DeepEqual(res.SpecialRoles, expectedRoles) {
+		t.Errorf("unexpected roles: %v", res.SpecialRoles)
+	}
+}
+
+func TestDecodeEsdtStorageSFT(t *testing.T) {
+	res, err := DecodeEsdtStorage(decodeFixture(t, fixtureSFT))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if res.TickerName != "ITEM" || res.TokenType != "SemiFungibleESDT" {
+		t.Errorf("unexpected token details: %+v", res)
+	}
+	if !res.CanChangeOwner || !res.CanAddSpecialRoles || res.Upgradable {
+		t.Errorf("unexpected flags: %+v", res)
+	}
+
+	expectedRoles := map[string][]string{
+		fixtureHolder: {"ESDTRoleNFTCreate", "ESDTRoleNFTAddQuantity"},
+	}
+	if !reflect.DeepEqual(res.SpecialRoles, expectedRoles) {
+		t.Errorf("unexpected roles: %v", res.SpecialRoles)
+	}
+}
+
+func TestDecodeEsdtStorageMetaESDT(t *testing.T) {
+	res, err := DecodeEsdtStorage(decodeFixture(t, fixtureMetaESDT))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if res.TickerName != "LKMEX" || res.TokenType != "MetaESDT" || res.NumDecimals != 18 || !res.Upgradable {
+		t.Errorf("unexpected token details: %+v", res)
+	}
+	if len(res.SpecialRoles) != 0 {
+		t.Errorf("unexpected roles: %v", res.SpecialRoles)
+	}
+}
+
+func TestDecodeEsdtStorageInvalid(t *testing.T) {
+	validHeader := "1a03544b4e220c46756e6769626c6545534454"
+	tests := map[string]string{
+		"empty":                 "",
+		"truncated":             fixtureFungible[:len(fixtureFungible)-10],
+		"truncated varint":      validHeader + "78ff",
+		"truncated length":      validHeader + "6a05",
+		"missing ticker":        "220c46756e6769626c6545534454",
+		"missing token type":    "1a03544b4e",
+		"bool as bytes":         validHeader + "2a0101",
+		"big int as varint":     validHeader + "6801",
+		"invalid big int sign":  validHeader + "6a020201",
+		"unsupported wire type": validHeader + "2b",
+		"invalid roles":         validHeader + "9a01020801",
+	}
+	for name, fixture := range tests {
+		_, err := DecodeEsdtStorage(decodeFixture(t, fixture))
+		if !errors.Is(err, utils.ErrInvalidProtobuf) {
+			t.Errorf("%s: expected %v, got %v", name, utils.ErrInvalidProtobuf, err)
+		}
+	}
+}
@@ -56,10 +56,47 @@ func (tok *Tokens) refreshTokens() {
 						tok.tokenStateChangedCallback(ticker, !newEsdt.IsPaused)
 					}
 				}
+				if newEsdt.Owner != oldEsdt.Owner && tok.tokenOwnerChangedCallback != nil {
+					tok.tokenOwnerChangedCallback(ticker, oldEsdt.Owner, newEsdt.Owner)
+				}
+				if tok.tokenRolesChangedCallback != nil {
+					tok.checkRolesChanged(ticker, oldEsdt.SpecialRoles, newEsdt.SpecialRoles)
+				}
 			}
 			tok.cachedEsdtsMut.Lock()
-			tok.cachedEsdts[ticker] = newEsdt
 		}
 	}
+	tok.cachedEsdts = newTokens
 	tok.cachedEsdtsMut.Unlock()
 }
+
+func (tok *Tokens) checkRolesChanged(ticker string, oldRoles map[string][]string, newRoles map[string][]string) {
+	for address, roles := range newRoles {
+		if !sameRoles(oldRoles[address], roles) {
+			tok.tokenRolesChangedCallback(ticker, address, oldRoles[address], roles)
+		}
+	}
+	for address, roles := range oldRoles {
+		if _, ok := newRoles[address]; !ok {
+			tok.tokenRolesChangedCallback(ticker, address, roles, nil)
+		}
+	}
+}
+
+func sameRoles(roles1 []string, roles2 []string) bool {
+	if len(roles1) != len(roles2) {
+		return false
+	}
+
+	set := make(map[string]bool)
+	for _, role := range roles1 {
+		set[role] = true
+	}
+	for _, role := range roles2 {
+		if !set[role] {
+			return false
+		}
+	}
+
+	return true
+}
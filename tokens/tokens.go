@@ -1,6 +1,7 @@
 package tokens
 
 import (
+	"bytes"
 	"encoding/hex"
 	"math/big"
 	"strconv"
@@ -8,6 +9,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/stakingagency/sa-mx-sdk-go/accounts"
 	"github.com/stakingagency/sa-mx-sdk-go/data"
@@ -19,6 +21,8 @@ type (
 	NewTokenIssuedCallbackFunc     func(ticker string)
 	TokenStateChangedCallbackFunc  func(ticker string, newState bool)
 	TokenSupplyChangedCallbackFunc func(ticker string, oldSupply float64, newSupply float64)
+	TokenOwnerChangedCallbackFunc  func(ticker string, oldOwner string, newOwner string)
+	TokenRolesChangedCallbackFunc  func(ticker string, address string, oldRoles []string, newRoles []string)
 )
 
 type Tokens struct {
@@ -28,12 +32,31 @@ type Tokens struct {
 
 	cachedEsdts    map[string]*data.ESDT
 	cachedEsdtsMut sync.Mutex
+	knownTokens    map[string]*knownToken
+	knownTokensMut sync.Mutex
+
+	workers               int
+	skipSupply            bool
+	supplyRefreshInterval time.Duration
 
 	newTokenIssuedCallback     NewTokenIssuedCallbackFunc
 	tokenStateChangedCallback  TokenStateChangedCallbackFunc
 	tokenSupplyChangedCallback TokenSupplyChangedCallbackFunc
+	tokenOwnerChangedCallback  TokenOwnerChangedCallbackFunc
+	tokenRolesChangedCallback  TokenRolesChangedCallbackFunc
+}
+
+type knownToken struct {
+	storage    []byte
+	esdt       *data.ESDT
+	supplyTime time.Time
 }
 
+const (
+	defaultWorkers               = 8
+	defaultSupplyRefreshInterval = 10 * time.Minute
+)
+
 var log = logger.GetOrCreate("tokens")
 
 func NewTokens(netMan *network.NetworkManager, refreshInterval time.Duration) (*Tokens, error) {
@@ -48,16 +71,38 @@ func NewTokens(netMan *network.NetworkManager, refreshInterval time.Duration) (*
 		refreshInterval:    refreshInterval,
 
 		cachedEsdts: make(map[string]*data.ESDT),
+		knownTokens: make(map[string]*knownToken),
+		workers:     defaultWorkers,
+
+		supplyRefreshInterval: defaultSupplyRefreshInterval,
 
 		newTokenIssuedCallback:     nil,
 		tokenStateChangedCallback:  nil,
 		tokenSupplyChangedCallback: nil,
+		tokenOwnerChangedCallback:  nil,
+		tokenRolesChangedCallback:  nil,
 	}
 	t.startTasks()
 
 	return t, nil
 }
 
+func (tok *Tokens) SetWorkers(workers int) {
+	if workers < 1 {
+		workers = 1
+	}
+	tok.workers = workers
+}
+
+func (tok *Tokens) SetSkipSupply(skip bool) {
+	tok.skipSupply = skip
+}
+
+// local mints and burns don't change the ESDT system SC storage, so the supply of all tokens is re-queried at this interval
+func (tok *Tokens) SetSupplyRefreshInterval(interval time.Duration) {
+	tok.supplyRefreshInterval = interval
+}
+
 func (tok *Tokens) SetNewTokenIssuedCallback(f NewTokenIssuedCallbackFunc) {
 	tok.newTokenIssuedCallback = f
 }
@@ -70,6 +115,14 @@ func (tok *Tokens) SetTokenSupplyChangedCallback(f TokenSupplyChangedCallbackFun
 	tok.tokenSupplyChangedCallback = f
 }
 
+func (tok *Tokens) SetTokenOwnerChangedCallback(f TokenOwnerChangedCallbackFunc) {
+	tok.tokenOwnerChangedCallback = f
+}
+
+func (tok *Tokens) SetTokenRolesChangedCallback(f TokenRolesChangedCallbackFunc) {
+	tok.tokenRolesChangedCallback = f
+}
+
 func (tok *Tokens) GetCachedTokens() (map[string]*data.ESDT, error) {
 	if tok.refreshInterval == utils.NoRefresh {
 		return nil, utils.ErrRefreshIntervalNotSet
@@ -86,95 +139,152 @@ func (tok *Tokens) GetCachedTokens() (map[string]*data.ESDT, error) {
 }
 
 func (tok *Tokens) GetTokens() (map[string]*data.ESDT, error) {
-	tokens := make(map[string]*data.ESDT)
 	keys, err := tok.esdtIssueScAccount.GetAccountKeys("")
 	if err != nil {
 		return nil, err
 	}
 
-	for bTicker, bytes := range keys {
+	tok.knownTokensMut.Lock()
+	knownTokens := tok.knownTokens
+	tok.knownTokensMut.Unlock()
+
+	tokens := make(map[string]*data.ESDT)
+	newKnownTokens := make(map[string]*knownToken)
+	mut := sync.Mutex{}
+	wg := sync.WaitGroup{}
+	jobs := make(chan *knownToken)
+	for i := 0; i < tok.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				if !tok.skipSupply && time.Since(job.supplyTime) >= tok.supplyRefreshInterval {
+					err := tok.getTokenMintInfo(job.esdt)
+					if err != nil {
+						log.Debug("get token mint info", "error", err, "ticker", job.esdt.Ticker, "function", "GetTokens")
+						continue
+					}
+
+					job.supplyTime = time.Now()
+				}
+
+				mut.Lock()
+				tokens[job.esdt.Ticker] = job.esdt
+				newKnownTokens[job.esdt.Ticker] = job
+				mut.Unlock()
+			}
+		}()
+	}
+
+	for bTicker, storage := range keys {
 		bToken, err := hex.DecodeString(bTicker)
 		if err != nil {
 			continue
 		}
 
-		ticker := string(bToken)
-		idx := 35
-		allOk := true
-		bName, idx, ok := utils.ParseByteArray(bytes, idx)
-		allOk = allOk && ok
-		_, idx, ok = utils.ParseByte(bytes, idx) // dummy 1
-		allOk = allOk && ok
-		bShort, idx, ok := utils.ParseByteArray(bytes, idx)
-		allOk = allOk && ok
-		_, idx, ok = utils.ParseByte(bytes, idx) // dummy 2
-		allOk = allOk && ok
-		bTokenType, idx, ok := utils.ParseByteArray(bytes, idx) // dummy 3 = token type (FungibleESDT)
-		tokenType := string(bTokenType)
-		if tokenType != "FungibleESDT" && string(tokenType) != "MetaESDT" {
-			continue
+		// tokens with unchanged storage keep their properties and, until it expires, their supply
+		job := &knownToken{
+			storage: storage,
 		}
-		allOk = allOk && ok
-		_, idx, ok = utils.ParseByte(bytes, idx) // dummy 4
-		allOk = allOk && ok
-		for allOk {
-			var dummy []byte
-			dummy, idx, ok = utils.ParseByteArray(bytes, idx) // dummy n
-			allOk = allOk && ok
-			if len(dummy) > 1 { // reached supply
-				break
+		previous := knownTokens[string(bToken)]
+		if previous != nil && bytes.Equal(previous.storage, storage) {
+			esdt := *previous.esdt
+			job.esdt = &esdt
+			job.supplyTime = previous.supplyTime
+		} else {
+			job.esdt, err = parseTokenStorage(string(bToken), storage)
+			if err != nil {
+				log.Debug("decode esdt storage", "error", err, "key", bTicker, "function", "GetTokens")
+				continue
 			}
 		}
-		_, idx, ok = utils.ParseByte(bytes, idx) // dummy 5
-		allOk = allOk && ok
-		_, idx, ok = utils.ParseByteArray(bytes, idx) // dummy 6
-		allOk = allOk && ok
-		_, idx, ok = utils.ParseByte(bytes, idx) // dummy 7
-		allOk = allOk && ok
-		decimals, _, ok := utils.ParseByte(bytes, idx)
-		allOk = allOk && ok
-
-		if !allOk {
+
+		if job.esdt.Type != "FungibleESDT" && job.esdt.Type != "MetaESDT" {
 			continue
 		}
 
-		esdt := &data.ESDT{
-			Name:        string(bName),
-			Ticker:      ticker,
-			ShortTicker: string(bShort),
-			Decimals:    uint64(decimals),
-			Type:        tokenType,
-		}
-		err = tok.getTokenMintInfo(esdt)
+		jobs <- job
+	}
+	close(jobs)
+	wg.Wait()
+
+	tok.knownTokensMut.Lock()
+	tok.knownTokens = newKnownTokens
+	tok.knownTokensMut.Unlock()
+
+	return tokens, nil
+}
+
+func (tok *Tokens) GetCollections() (map[string]*data.ESDT, error) {
+	collections := make(map[string]*data.ESDT)
+	keys, err := tok.esdtIssueScAccount.GetAccountKeys("")
+	if err != nil {
+		return nil, err
+	}
+
+	for bTicker, bytes := range keys {
+		bToken, err := hex.DecodeString(bTicker)
 		if err != nil {
 			continue
 		}
 
-		esdt.IsPaused, err = tok.IsTokenPaused(ticker)
+		esdt, err := parseTokenStorage(string(bToken), bytes)
 		if err != nil {
+			log.Debug("decode esdt storage", "error", err, "key", bTicker, "function", "GetCollections")
 			continue
 		}
 
-		tokens[esdt.Ticker] = esdt
+		if esdt.Type != "NonFungibleESDT" && esdt.Type != "SemiFungibleESDT" && esdt.Type != "MetaESDT" {
+			continue
+		}
+
+		collections[esdt.Ticker] = esdt
 	}
 
-	return tokens, nil
+	return collections, nil
 }
 
-func (tok *Tokens) IsTokenPaused(ticker string) (bool, error) {
-	args := []string{hex.EncodeToString([]byte(ticker))}
-	res, err := tok.netMan.QueryScMultiIntResult(utils.EsdtIssueSC, "getTokenProperties", args)
+func (tok *Tokens) GetCollectionProperties(collection string) (*data.ESDT, error) {
+	return tok.queryTokenProperties(collection)
+}
+
+func parseTokenStorage(ticker string, bytes []byte) (*data.ESDT, error) {
+	storage, err := DecodeEsdtStorage(bytes)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
-	if len(res) < 7 {
-		return false, utils.ErrInvalidResponse
+	esdt := &data.ESDT{
+		Name:                     storage.TokenName,
+		Ticker:                   ticker,
+		ShortTicker:              storage.TickerName,
+		Decimals:                 uint64(storage.NumDecimals),
+		Type:                     storage.TokenType,
+		Owner:                    storage.Owner,
+		IsPaused:                 storage.Paused,
+		CanUpgrade:               storage.Upgradable,
+		CanMint:                  storage.Mintable,
+		CanBurn:                  storage.Burnable,
+		CanChangeOwner:           storage.CanChangeOwner,
+		CanPause:                 storage.CanPause,
+		CanFreeze:                storage.CanFreeze,
+		CanWipe:                  storage.CanWipe,
+		CanAddSpecialRoles:       storage.CanAddSpecialRoles,
+		CanTransferNFTCreateRole: storage.CanTransferNFTCreateRole,
+		NFTCreateStopped:         storage.NFTCreateStopped,
+		SpecialRoles:             storage.SpecialRoles,
 	}
 
-	isPaused := string(res[6].Bytes()) == "IsPaused-true"
+	return esdt, nil
+}
 
-	return isPaused, nil
+func (tok *Tokens) IsTokenPaused(ticker string) (bool, error) {
+	esdt, err := tok.queryTokenProperties(ticker)
+	if err != nil {
+		return false, err
+	}
+
+	return esdt.IsPaused, nil
 }
 
 func (tok *Tokens) GetCachedTokenProperties(ticker string) (*data.ESDT, error) {
@@ -198,37 +308,104 @@ func (tok *Tokens) GetCachedTokenProperties(ticker string) (*data.ESDT, error) {
 }
 
 func (tok *Tokens) GetTokenProperties(ticker string) (*data.ESDT, error) {
-	args := []string{hex.EncodeToString([]byte(ticker))}
-	res, err := tok.netMan.QueryScMultiIntResult(utils.EsdtIssueSC, "getTokenProperties", args)
+	esdt, err := tok.queryTokenProperties(ticker)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(res) < 7 {
-		return nil, utils.ErrInvalidResponse
+	err = tok.getTokenMintInfo(esdt)
+	if err != nil {
+		return nil, err
 	}
 
-	sDecimals := strings.TrimPrefix(string(res[5].Bytes()), "NumDecimals-")
-	decimals, err := strconv.ParseUint(sDecimals, 10, 64)
+	return esdt, nil
+}
+
+func (tok *Tokens) queryTokenProperties(ticker string) (*data.ESDT, error) {
+	args := []string{hex.EncodeToString([]byte(ticker))}
+	res, err := tok.netMan.QuerySC(utils.EsdtIssueSC, "getTokenProperties", args)
 	if err != nil {
+		return nil, err
+	}
+
+	if len(res.Data.ReturnData) < 7 {
 		return nil, utils.ErrInvalidResponse
 	}
 
 	esdt := &data.ESDT{
-		Name:        string(res[0].Bytes()),
-		Type:        string(res[1].Bytes()),
+		Name:        string(res.Data.ReturnData[0]),
+		Type:        string(res.Data.ReturnData[1]),
 		Ticker:      ticker,
 		ShortTicker: strings.Split(ticker, "-")[0],
-		Decimals:    decimals,
-		IsPaused:    string(res[6].Bytes()) == "IsPaused-true",
 	}
 
-	err = tok.getTokenMintInfo(esdt)
+	conv, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	esdt.Owner, _ = conv.Encode(res.Data.ReturnData[2])
+
+	decimalsOk := false
+	for _, bProperty := range res.Data.ReturnData[5:] {
+		property := strings.SplitN(string(bProperty), "-", 2)
+		if len(property) != 2 {
+			continue
+		}
+
+		value := property[1] == "true"
+		switch property[0] {
+		case "NumDecimals":
+			esdt.Decimals, err = strconv.ParseUint(property[1], 10, 64)
+			if err != nil {
+				return nil, utils.ErrInvalidResponse
+			}
+			decimalsOk = true
+		case "IsPaused":
+			esdt.IsPaused = value
+		case "CanUpgrade":
+			esdt.CanUpgrade = value
+		case "CanMint":
+			esdt.CanMint = value
+		case "CanBurn":
+			esdt.CanBurn = value
+		case "CanChangeOwner":
+			esdt.CanChangeOwner = value
+		case "CanPause":
+			esdt.CanPause = value
+		case "CanFreeze":
+			esdt.CanFreeze = value
+		case "CanWipe":
+			esdt.CanWipe = value
+		case "CanAddSpecialRoles":
+			esdt.CanAddSpecialRoles = value
+		case "CanTransferNFTCreateRole":
+			esdt.CanTransferNFTCreateRole = value
+		case "NFTCreateStopped":
+			esdt.NFTCreateStopped = value
+		}
+	}
+	if !decimalsOk {
+		return nil, utils.ErrInvalidResponse
+	}
+
+	return esdt, nil
+}
+
+func (tok *Tokens) GetSpecialRoles(ticker string) (map[string][]string, error) {
+	args := []string{hex.EncodeToString([]byte(ticker))}
+	res, err := tok.netMan.QuerySC(utils.EsdtIssueSC, "getSpecialRoles", args)
 	if err != nil {
 		return nil, err
 	}
 
-	return esdt, nil
+	roles := make(map[string][]string)
+	for _, bEntry := range res.Data.ReturnData {
+		entry := strings.SplitN(string(bEntry), ":", 2)
+		if len(entry) != 2 {
+			return nil, utils.ErrInvalidResponse
+		}
+
+		roles[entry[0]] = strings.Split(entry[1], ",")
+	}
+
+	return roles, nil
 }
 
 func (tok *Tokens) getTokenMintInfo(token *data.ESDT) error {
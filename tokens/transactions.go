@@ -0,0 +1,294 @@
+package tokens
+
+import (
+	"encoding/hex"
+	"math"
+	"math/big"
+	"strings"
+
+	"github.com/stakingagency/sa-mx-sdk-go/data"
+	"github.com/stakingagency/sa-mx-sdk-go/network"
+	"github.com/stakingagency/sa-mx-sdk-go/utils"
+)
+
+const (
+	RoleLocalMint           = "ESDTRoleLocalMint"
+	RoleLocalBurn           = "ESDTRoleLocalBurn"
+	RoleNFTCreate           = "ESDTRoleNFTCreate"
+	RoleNFTBurn             = "ESDTRoleNFTBurn"
+	RoleNFTAddQuantity      = "ESDTRoleNFTAddQuantity"
+	RoleNFTUpdateAttributes = "ESDTRoleNFTUpdateAttributes"
+	RoleNFTAddURI           = "ESDTRoleNFTAddURI"
+	RoleTransfer            = "ESDTTransferRole"
+
+	issueCost          = 0.05
+	issueGasLimit      = uint64(60000000)
+	managementGasLimit = uint64(60000000)
+	localGasLimit      = uint64(300000)
+	nftCreateGasLimit  = uint64(3000000)
+)
+
+type IssueProperties struct {
+	CanFreeze                bool
+	CanWipe                  bool
+	CanPause                 bool
+	CanChangeOwner           bool
+	CanUpgrade               bool
+	CanAddSpecialRoles       bool
+	CanTransferNFTCreateRole bool
+}
+
+func (tok *Tokens) IssueFungibleToken(pk []byte, name string, ticker string, initialSupply float64, decimals uint64, props *IssueProperties) (string, error) {
+	args := []string{
+		utils.StringArg(name),
+		utils.StringArg(ticker),
+		utils.BigIntArg(utils.Renominate(initialSupply, int(decimals))),
+		utils.Uint64Arg(decimals),
+	}
+	args = append(args, propertiesArgs(props, false)...)
+
+	return tok.issue(pk, "issue", args)
+}
+
+func (tok *Tokens) IssueSemiFungibleToken(pk []byte, name string, ticker string, props *IssueProperties) (string, error) {
+	args := []string{
+		utils.StringArg(name),
+		utils.StringArg(ticker),
+	}
+	args = append(args, propertiesArgs(props, true)...)
+
+	return tok.issue(pk, "issueSemiFungible", args)
+}
+
+func (tok *Tokens) IssueNonFungibleToken(pk []byte, name string, ticker string, props *IssueProperties) (string, error) {
+	args := []string{
+		utils.StringArg(name),
+		utils.StringArg(ticker),
+	}
+	args = append(args, propertiesArgs(props, true)...)
+
+	return tok.issue(pk, "issueNonFungible", args)
+}
+
+func (tok *Tokens) RegisterMetaToken(pk []byte, name string, ticker string, decimals uint64, props *IssueProperties) (string, error) {
+	args := []string{
+		utils.StringArg(name),
+		utils.StringArg(ticker),
+		utils.Uint64Arg(decimals),
+	}
+	args = append(args, propertiesArgs(props, true)...)
+
+	return tok.issue(pk, "registerMetaESDT", args)
+}
+
+func (tok *Tokens) SetSpecialRoles(pk []byte, ticker string, address string, roles ...string) error {
+	return tok.changeSpecialRoles(pk, "setSpecialRole", ticker, address, roles)
+}
+
+func (tok *Tokens) UnsetSpecialRoles(pk []byte, ticker string, address string, roles ...string) error {
+	return tok.changeSpecialRoles(pk, "unSetSpecialRole", ticker, address, roles)
+}
+
+func (tok *Tokens) LocalMint(pk []byte, token *data.ESDT, amount float64) error {
+	args := []string{
+		utils.StringArg(token.Ticker),
+		utils.BigIntArg(utils.Renominate(amount, int(token.Decimals))),
+	}
+
+	return tok.sendToSelf(pk, localGasLimit, "ESDTLocalMint", args)
+}
+
+func (tok *Tokens) LocalBurn(pk []byte, token *data.ESDT, amount float64) error {
+	args := []string{
+		utils.StringArg(token.Ticker),
+		utils.BigIntArg(utils.Renominate(amount, int(token.Decimals))),
+	}
+
+	return tok.sendToSelf(pk, localGasLimit, "ESDTLocalBurn", args)
+}
+
+func (tok *Tokens) PauseToken(pk []byte, ticker string) error {
+	_, err := tok.sendManagementTx(pk, 0, managementGasLimit, "pause", []string{utils.StringArg(ticker)})
+
+	return err
+}
+
+func (tok *Tokens) UnpauseToken(pk []byte, ticker string) error {
+	_, err := tok.sendManagementTx(pk, 0, managementGasLimit, "unPause", []string{utils.StringArg(ticker)})
+
+	return err
+}
+
+func (tok *Tokens) FreezeAccount(pk []byte, ticker string, address string) error {
+	return tok.accountOperation(pk, "freeze", ticker, address)
+}
+
+func (tok *Tokens) UnfreezeAccount(pk []byte, ticker string, address string) error {
+	return tok.accountOperation(pk, "unFreeze", ticker, address)
+}
+
+func (tok *Tokens) WipeAccount(pk []byte, ticker string, address string) error {
+	return tok.accountOperation(pk, "wipe", ticker, address)
+}
+
+func (tok *Tokens) TransferOwnership(pk []byte, ticker string, newOwner string) error {
+	return tok.accountOperation(pk, "transferOwnership", ticker, newOwner)
+}
+
+func (tok *Tokens) CreateNFT(pk []byte, collection string, quantity *big.Int, name string, royalties float64,
+	nftHash []byte, attributes []byte, uris []string,
+) (uint64, error) {
+	args := []string{
+		utils.StringArg(collection),
+		utils.BigIntArg(quantity),
+		utils.StringArg(name),
+		utils.Uint64Arg(uint64(math.Round(royalties * 100))),
+		hex.EncodeToString(nftHash),
+		hex.EncodeToString(attributes),
+	}
+	for _, uri := range uris {
+		args = append(args, utils.StringArg(uri))
+	}
+	if len(uris) == 0 {
+		args = append(args, "")
+	}
+
+	sender, err := network.GetAddressFromPrivateKey(pk)
+	if err != nil {
+		return 0, err
+	}
+
+	dataField := "ESDTNFTCreate@" + strings.Join(args, "@")
+	gasLimit := nftCreateGasLimit + uint64(len(dataField))*1500
+	hash, err := tok.sendTx(pk, sender, 0, gasLimit, dataField)
+	if err != nil {
+		return 0, err
+	}
+
+	events, err := tok.netMan.GetTxEvents(hash, "ESDTNFTCreate")
+	if err != nil {
+		return 0, err
+	}
+
+	for _, event := range events {
+		if len(event.Topics) < 2 || utils.Base64Decode(event.Topics[0]) != collection {
+			continue
+		}
+
+		nonce := big.NewInt(0).SetBytes([]byte(utils.Base64Decode(event.Topics[1])))
+
+		return nonce.Uint64(), nil
+	}
+
+	return 0, utils.ErrEventNotFound
+}
+
+func (tok *Tokens) issue(pk []byte, function string, args []string) (string, error) {
+	hash, err := tok.sendManagementTx(pk, issueCost, issueGasLimit, function, args)
+	if err != nil {
+		return "", err
+	}
+
+	events, err := tok.netMan.GetTxEvents(hash, function)
+	if err != nil {
+		return "", err
+	}
+
+	for _, event := range events {
+		if len(event.Topics) == 0 {
+			continue
+		}
+
+		return utils.Base64Decode(event.Topics[0]), nil
+	}
+
+	return "", utils.ErrEventNotFound
+}
+
+func (tok *Tokens) changeSpecialRoles(pk []byte, function string, ticker string, address string, roles []string) error {
+	sAddress, err := utils.AddressArg(address)
+	if err != nil {
+		return err
+	}
+
+	args := []string{utils.StringArg(ticker), sAddress}
+	for _, role := range roles {
+		args = append(args, utils.StringArg(role))
+	}
+
+	_, err = tok.sendManagementTx(pk, 0, managementGasLimit, function, args)
+
+	return err
+}
+
+func (tok *Tokens) accountOperation(pk []byte, function string, ticker string, address string) error {
+	sAddress, err := utils.AddressArg(address)
+	if err != nil {
+		return err
+	}
+
+	args := []string{utils.StringArg(ticker), sAddress}
+	_, err = tok.sendManagementTx(pk, 0, managementGasLimit, function, args)
+
+	return err
+}
+
+func (tok *Tokens) sendManagementTx(pk []byte, value float64, gasLimit uint64, function string, args []string) (string, error) {
+	dataField := function + "@" + strings.Join(args, "@")
+
+	return tok.sendTx(pk, utils.EsdtIssueSC, value, gasLimit, dataField)
+}
+
+func (tok *Tokens) sendToSelf(pk []byte, gasLimit uint64, function string, args []string) error {
+	sender, err := network.GetAddressFromPrivateKey(pk)
+	if err != nil {
+		return err
+	}
+
+	dataField := function + "@" + strings.Join(args, "@")
+	_, err = tok.sendTx(pk, sender, 0, gasLimit, dataField)
+
+	return err
+}
+
+func (tok *Tokens) sendTx(pk []byte, receiver string, value float64, gasLimit uint64, dataField string) (string, error) {
+	hash, err := tok.netMan.SendTransaction(pk, receiver, value, gasLimit, dataField, utils.AutoNonce)
+	if err != nil {
+		return "", err
+	}
+
+	err = tok.netMan.GetTxResult(hash)
+	if err != nil {
+		return hash, err
+	}
+
+	return hash, nil
+}
+
+func propertiesArgs(props *IssueProperties, isNft bool) []string {
+	if props == nil {
+		props = &IssueProperties{}
+	}
+
+	names := []string{"canFreeze", "canWipe", "canPause", "canChangeOwner", "canUpgrade", "canAddSpecialRoles"}
+	values := []bool{props.CanFreeze, props.CanWipe, props.CanPause, props.CanChangeOwner, props.CanUpgrade, props.CanAddSpecialRoles}
+	if isNft {
+		names = append(names, "canTransferNFTCreateRole")
+		values = append(values, props.CanTransferNFTCreateRole)
+	}
+
+	args := make([]string, 0)
+	for i, name := range names {
+		args = append(args, utils.StringArg(name), utils.StringArg(boolString(values[i])))
+	}
+
+	return args
+}
+
+func boolString(value bool) string {
+	if value {
+		return "true"
+	}
+
+	return "false"
+}
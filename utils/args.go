@@ -0,0 +1,42 @@
+package utils
+
+import (
+	"encoding/hex"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+)
+
+func StringArg(s string) string {
+	return hex.EncodeToString([]byte(s))
+}
+
+func BigIntArg(value *big.Int) string {
+	if value == nil || value.Sign() == 0 {
+		return "00"
+	}
+
+	return hex.EncodeToString(value.Bytes())
+}
+
+func Uint64Arg(value uint64) string {
+	return BigIntArg(big.NewInt(0).SetUint64(value))
+}
+
+func BoolArg(value bool) string {
+	if value {
+		return "01"
+	}
+
+	return "00"
+}
+
+func AddressArg(address string) (string, error) {
+	conv, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	pubkey, err := conv.Decode(address)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(pubkey), nil
+}
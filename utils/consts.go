@@ -8,6 +8,9 @@ const (
 	EsdtIssueSC         = "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqzllls8a5w6u"
 	DexRouterSC         = "erd1qqqqqqqqqqqqqpgqq66xk9gfr4esuhem3jru86wg5hvp33a62jps2fy57p"
 	ContractDeploy      = "erd1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq6gq4hu"
+	SalsaSC             = "erd1qqqqqqqqqqqqqpgqaqxztq0y764dnet95jwtse5u5zkg92sfacts6h9su3"
+	HatomLiquidSC       = "erd1qqqqqqqqqqqqqpgq4gzfcw7kmkjy8zsf04ce6dl0auhtzjx078sslvrf4e"
+	EnergyFactorySC     = "erd1qqqqqqqqqqqqqpgq0tajepcazernwt74820t8ef7t28vjfgukp2sw239f3"
 
 	USDC  = "USDC-c76f1f"
 	USDT  = "USDT-f8c08c"
@@ -15,6 +18,9 @@ const (
 	WEGLD = "WEGLD-bd4d79"
 	MEX   = "MEX-455c57"
 
+	DexXExchange = "xExchange"
+	DexOneDex    = "OneDex"
+
 	AutoNonce    = 0xFFFFFFFFFFFFFFFF
 	AutoGasLimit = uint64(0)
 	NoRefresh    = time.Duration(0)
@@ -8,4 +8,38 @@ var (
 	ErrTxNotFound            = errors.New("tx not found")
 	ErrTimeout               = errors.New("timeout")
 	ErrRefreshIntervalNotSet = errors.New("refresh interval not set")
+	ErrEventNotFound         = errors.New("event not found")
+	ErrInvalidProtobuf       = errors.New("invalid protobuf data")
+	ErrBelowMinDelegation    = errors.New("amount below minimum delegation")
+	ErrDelegationCapReached  = errors.New("delegation cap reached")
+	ErrInsufficientStake     = errors.New("insufficient stake")
+	ErrNothingToClaim        = errors.New("nothing to claim")
+	ErrNothingToWithdraw     = errors.New("nothing to withdraw")
+	ErrNotOwner              = errors.New("sender is not the owner")
+	ErrInvalidServiceFee     = errors.New("invalid service fee")
+	ErrInvalidDelegationCap  = errors.New("invalid delegation cap")
+	ErrInvalidBlsKey         = errors.New("invalid bls key")
+	ErrNoChange              = errors.New("value not changed")
+	ErrTokenNotInPair        = errors.New("token not in pair")
+	ErrInsufficientLiquidity = errors.New("insufficient liquidity")
+	ErrInvalidSlippage       = errors.New("invalid slippage")
+	ErrPairNotActive         = errors.New("pair not active")
+	ErrInvalidRoute          = errors.New("invalid route")
+	ErrNoRouteFound          = errors.New("no route found")
+	ErrPairNotFound          = errors.New("pair not found")
+	ErrInvalidInterval       = errors.New("invalid interval")
+	ErrInvalidPercent        = errors.New("invalid percent")
+	ErrNoSwapEvents          = errors.New("no swap events")
+	ErrPriceNotFound         = errors.New("price not found")
+	ErrSlippageExceeded      = errors.New("slippage exceeded")
+	ErrInvalidVoteType       = errors.New("invalid vote type")
+	ErrInvalidAmount         = errors.New("invalid amount")
+	ErrContractNotSet        = errors.New("contract address not set")
+	ErrBelowMinStake         = errors.New("amount below minimum stake")
+	ErrLaunchpadNotLive      = errors.New("launchpad not live")
+	ErrHardCapReached        = errors.New("hard cap reached")
+	ErrBelowMinBuy           = errors.New("amount below minimum buy limit")
+	ErrAboveMaxBuy           = errors.New("amount above maximum buy limit")
+	ErrInvalidVersion        = errors.New("invalid contract version")
+	ErrNotSupported          = errors.New("not supported by the contract version")
 )
@@ -0,0 +1,78 @@
+package utils
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+const (
+	ProtoWireVarint  = 0
+	ProtoWireFixed64 = 1
+	ProtoWireBytes   = 2
+	ProtoWireFixed32 = 5
+)
+
+type ProtoField struct {
+	Number   uint64
+	WireType byte
+	Varint   uint64
+	Bytes    []byte
+}
+
+func ParseProtoFields(bytes []byte) ([]*ProtoField, error) {
+	fields := make([]*ProtoField, 0)
+	idx := 0
+	for idx < len(bytes) {
+		key, n := binary.Uvarint(bytes[idx:])
+		if n <= 0 {
+			return nil, fmt.Errorf("%w: invalid field key at offset %v", ErrInvalidProtobuf, idx)
+		}
+
+		idx += n
+		field := &ProtoField{
+			Number:   key >> 3,
+			WireType: byte(key & 7),
+		}
+		if field.Number == 0 {
+			return nil, fmt.Errorf("%w: invalid field number at offset %v", ErrInvalidProtobuf, idx-n)
+		}
+
+		switch field.WireType {
+		case ProtoWireVarint:
+			field.Varint, n = binary.Uvarint(bytes[idx:])
+			if n <= 0 {
+				return nil, fmt.Errorf("%w: invalid varint for field %v", ErrInvalidProtobuf, field.Number)
+			}
+			idx += n
+		case ProtoWireFixed64:
+			if idx+8 > len(bytes) {
+				return nil, fmt.Errorf("%w: truncated fixed64 for field %v", ErrInvalidProtobuf, field.Number)
+			}
+			field.Varint = binary.LittleEndian.Uint64(bytes[idx : idx+8])
+			idx += 8
+		case ProtoWireBytes:
+			length, n := binary.Uvarint(bytes[idx:])
+			if n <= 0 {
+				return nil, fmt.Errorf("%w: invalid length for field %v", ErrInvalidProtobuf, field.Number)
+			}
+			idx += n
+			if length > uint64(len(bytes)-idx) {
+				return nil, fmt.Errorf("%w: truncated bytes for field %v", ErrInvalidProtobuf, field.Number)
+			}
+			field.Bytes = bytes[idx : idx+int(length)]
+			idx += int(length)
+		case ProtoWireFixed32:
+			if idx+4 > len(bytes) {
+				return nil, fmt.Errorf("%w: truncated fixed32 for field %v", ErrInvalidProtobuf, field.Number)
+			}
+			field.Varint = uint64(binary.LittleEndian.Uint32(bytes[idx : idx+4]))
+			idx += 4
+		default:
+			return nil, fmt.Errorf("%w: unsupported wire type %v for field %v", ErrInvalidProtobuf, field.WireType, field.Number)
+		}
+
+		fields = append(fields, field)
+	}
+
+	return fields, nil
+}
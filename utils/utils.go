@@ -82,6 +82,19 @@ func Denominate(iValue *big.Int, decimals int) float64 {
 	return res
 }
 
+const slippageFactor = int64(1000000)
+
+// increases (up) or decreases the amount by the slippage percent
+func ApplySlippage(amount *big.Int, slippage float64, up bool) *big.Int {
+	delta := int64(slippage * float64(slippageFactor) / 100)
+	if !up {
+		delta = -delta
+	}
+	res := big.NewInt(0).Mul(amount, big.NewInt(slippageFactor+delta))
+
+	return res.Quo(res, big.NewInt(slippageFactor))
+}
+
 func Renominate(value float64, decimals int) *big.Int {
 	fValue := big.NewFloat(value)
 	ten := big.NewFloat(10)
@@ -98,16 +111,59 @@ type priceType struct {
 	Price  string `price:"price"`
 }
 
+// Deprecated: errors are hidden behind a 0 price, use QueryBinancePrice or the pricing package
 func GetBinancePrice(symbol string) float64 {
+	p, _ := QueryBinancePrice(symbol)
+
+	return p
+}
+
+func QueryBinancePrice(symbol string) (float64, error) {
 	body, err := GetHTTP(fmt.Sprintf("https://api.binance.com/api/v3/ticker/price?symbol=%sUSDT", symbol), "")
 	if err != nil {
-		return 0
+		return 0, err
 	}
+
 	var pt priceType
-	json.Unmarshal(body, &pt)
-	p, err := strconv.ParseFloat(pt.Price, 64)
+	err = json.Unmarshal(body, &pt)
 	if err != nil {
-		return 0
+		return 0, err
 	}
-	return p
+
+	return strconv.ParseFloat(pt.Price, 64)
+}
+
+func GetNftIdentifier(collection string, nonce uint64) string {
+	sNonce := strconv.FormatUint(nonce, 16)
+	if len(sNonce)%2 == 1 {
+		sNonce = "0" + sNonce
+	}
+
+	return collection + "-" + sNonce
+}
+
+func SplitEsdtKey(key []byte) (string, uint64, bool) {
+	idx := bytes.IndexByte(key, '-')
+	if idx < 0 || len(key) < idx+7 {
+		return "", 0, false
+	}
+
+	ticker := string(key[:idx+7])
+	nonce := big.NewInt(0).SetBytes(key[idx+7:]).Uint64()
+
+	return ticker, nonce, true
+}
+
+func ParseNftAttributes(attributes []byte) map[string]string {
+	res := make(map[string]string)
+	for _, field := range strings.Split(string(attributes), ";") {
+		kv := strings.SplitN(field, ":", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			continue
+		}
+
+		res[kv[0]] = kv[1]
+	}
+
+	return res
 }